@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing objects can be imported with:
+#   terraform import versadirector_address.branch_lan devicename/orgname/branch-lan
+resource "versadirector_address" "branch_lan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "branch-lan"
+  description       = "Branch LAN subnet"
+  ipv4_prefix       = "10.1.0.0/16"
+}
+
+output "versa_address_info" {
+  value = versadirector_address.branch_lan
+}
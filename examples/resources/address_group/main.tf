@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+resource "versadirector_address" "branch_lan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "branch-lan"
+  ipv4_prefix       = "10.1.0.0/16"
+}
+
+# Existing groups can be imported with:
+#   terraform import versadirector_address_group.branch devicename/orgname/branch
+resource "versadirector_address_group" "branch" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "branch"
+  description       = "Branch networks"
+  addresses         = [versadirector_address.branch_lan.name]
+}
+
+data "versadirector_address_group" "branch" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = versadirector_address_group.branch.name
+}
+
+output "branch_group_members" {
+  value = data.versadirector_address_group.branch.addresses
+}
@@ -21,6 +21,8 @@ provider "versadirector" {
 resource "versadirector_addresses" "vos_addresses" {
   device_name       = "devicename"
   organization_name = "orgname"
+  # Set to true to delete addresses not listed below
+  exclusive = false
   address = [
     {
       name = "versa-networks-addresses1"
@@ -33,6 +35,30 @@ resource "versadirector_addresses" "vos_addresses" {
     {
       name = "versa-networks-addresses3"
       fqdn = "versa-networks.com/addresses"
+    },
+    {
+      name        = "branch-lan"
+      description = "Branch LAN subnet"
+      tags        = ["lan"]
+      ipv4_prefix = "10.1.0.0/16"
+    },
+    {
+      name        = "branch-lan-v6"
+      ipv6_prefix = "2001:db8::/32"
+    },
+    {
+      name = "dhcp-pool"
+      ipv4_range = {
+        start = "10.1.1.10"
+        end   = "10.1.1.200"
+      }
+    },
+    {
+      name = "branch-hosts"
+      ipv4_wildcard_mask = {
+        address = "10.0.1.0"
+        mask    = "0.0.255.255"
+      }
     }
   ]
 }
@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing definitions can be imported with:
+#   terraform import versadirector_custom_application.crm devicename/orgname/crm-saas
+resource "versadirector_custom_application" "crm" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "crm-saas"
+  family            = "business-system"
+  risk              = 2
+  productivity      = 5
+  rules = [
+    {
+      name         = "crm-web"
+      host_pattern = ".*\\.crm\\.example\\.com"
+    },
+    {
+      name             = "crm-sync"
+      protocol         = "tcp"
+      destination_port = "8443"
+    },
+  ]
+}
+
+resource "versadirector_custom_url_category" "partners" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "partner-sites"
+  urls              = ["partner.example.net"]
+  url_patterns      = [".*\\.partner\\.example\\.org"]
+}
@@ -0,0 +1,68 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing objects can be imported with:
+#   terraform import versadirector_nat_pool.internet devicename/orgname/internet-pool
+#   terraform import versadirector_nat_rule.lan devicename/orgname/lan-to-internet
+resource "versadirector_nat_pool" "internet" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "internet-pool"
+  prefixes          = ["192.0.2.0/28"]
+  port_range        = "1024-65535"
+  routing_instance  = "Internet-Transport-VR"
+}
+
+resource "versadirector_nat_pool" "web" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "web-servers"
+  ranges = [
+    {
+      start = "10.1.10.10"
+      end   = "10.1.10.12"
+    },
+  ]
+}
+
+# Source NAT for LAN hosts, evaluated before existing rules.
+resource "versadirector_nat_rule" "lan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "lan-to-internet"
+  source_zones      = ["LAN"]
+  source_prefixes   = ["10.1.0.0/16"]
+  translation_type  = "napt-44"
+  pool              = versadirector_nat_pool.internet.name
+  position          = "top"
+}
+
+# Destination NAT of a public address to the web servers.
+resource "versadirector_nat_rule" "web" {
+  device_name          = "devicename"
+  organization_name    = "orgname"
+  name                 = "web-inbound"
+  destination_prefixes = ["192.0.2.20/32"]
+  protocol             = "tcp"
+  destination_port     = "443"
+  translation_type     = "dnat"
+  pool                 = versadirector_nat_pool.web.name
+  position             = "after"
+  relative_to          = versadirector_nat_rule.lan.name
+}
@@ -0,0 +1,57 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing objects can be imported with:
+#   terraform import versadirector_qos_profile.video devicename/orgname/video-shaper
+#   terraform import versadirector_app_qos_policy_rule.video devicename/orgname/Default-Policy/video
+resource "versadirector_qos_profile" "video" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "video-shaper"
+  peak_rate         = "20Mbps"
+  burst_size        = 125000
+  forwarding_class  = "fc8"
+  loss_priority     = "low"
+}
+
+resource "versadirector_app_qos_policy_rule" "video" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  policy_name       = "Default-Policy"
+  name              = "video"
+  applications      = ["YOUTUBE"]
+  qos_profile       = versadirector_qos_profile.video.name
+}
+
+resource "versadirector_class_of_service_mapping" "wan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "wan-classifier"
+  mappings = [
+    {
+      dscp             = [46]
+      forwarding_class = "fc1"
+    },
+    {
+      dscp             = [34, 36, 38]
+      forwarding_class = "fc4"
+      loss_priority    = "high"
+    },
+  ]
+}
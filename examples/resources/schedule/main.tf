@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+resource "versadirector_schedule" "business_hours" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "business-hours"
+  weekly = [
+    for day in ["monday", "tuesday", "wednesday", "thursday", "friday"] : {
+      day   = day
+      start = "08:00"
+      end   = "18:00"
+    }
+  ]
+}
+
+resource "versadirector_schedule" "maintenance" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "maintenance-window"
+  non_recurring = [
+    {
+      start = "2024-01-31T22:00"
+      end   = "2024-02-01T02:00"
+    }
+  ]
+}
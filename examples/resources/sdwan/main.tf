@@ -0,0 +1,60 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing objects can be imported with:
+#   terraform import versadirector_sla_profile.voice devicename/orgname/voice-sla
+#   terraform import versadirector_sdwan_policy_rule.voice devicename/orgname/Default-Policy/voice
+resource "versadirector_sla_profile" "voice" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "voice-sla"
+  latency           = 150
+  jitter            = 30
+  loss              = 1.5
+}
+
+resource "versadirector_forwarding_profile" "voice" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "voice-fp"
+  circuit_priorities = [
+    {
+      priority = 1
+      circuits = ["MPLS"]
+    },
+    {
+      priority = 2
+      circuits = ["Internet"]
+    },
+  ]
+  sla_profile        = versadirector_sla_profile.voice.name
+  replication        = true
+  replication_factor = 2
+}
+
+resource "versadirector_sdwan_policy_rule" "voice" {
+  device_name        = "devicename"
+  organization_name  = "orgname"
+  policy_name        = "Default-Policy"
+  name               = "voice"
+  applications       = ["SIP", "RTP"]
+  dscp               = [46]
+  forwarding_profile = versadirector_forwarding_profile.voice.name
+  position           = "top"
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing policies and rules can be imported with:
+#   terraform import versadirector_security_policy.default devicename/orgname/Default-Policy
+#   terraform import versadirector_security_rule.allow_web devicename/orgname/Default-Policy/allow-web
+resource "versadirector_security_policy" "default" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "Default-Policy"
+}
+
+resource "versadirector_security_rule" "allow_web" {
+  device_name           = "devicename"
+  organization_name     = "orgname"
+  policy_name           = versadirector_security_policy.default.name
+  name                  = "allow-web"
+  source_zones          = ["trust"]
+  destination_zones     = ["untrust"]
+  services              = ["http", "https"]
+  action                = "allow"
+  url_filtering_profile = "corporate-urlf"
+}
+
+resource "versadirector_security_rule" "block_p2p" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  policy_name       = versadirector_security_policy.default.name
+  name              = "block-p2p"
+  applications      = ["BITTORRENT"]
+  action            = "deny"
+  position          = "before"
+  relative_to       = versadirector_security_rule.allow_web.name
+}
@@ -0,0 +1,96 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing profiles can be imported with:
+#   terraform import versadirector_url_filtering_profile.corporate devicename/orgname/corporate-urlf
+resource "versadirector_url_filtering_profile" "corporate" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "corporate-urlf"
+  default_action    = "allow"
+  category_actions = [
+    {
+      name           = "block-risky"
+      action         = "block"
+      url_categories = ["malware_sites", "phishing_and_other_frauds"]
+    },
+  ]
+  allow_list = ["*.example.com"]
+  deny_list  = ["badsite.example.org"]
+}
+
+resource "versadirector_ips_profile" "strict" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "strict-ips"
+  rules = [
+    {
+      name       = "drop-severe"
+      severities = ["critical", "major"]
+      action     = "drop-session"
+    },
+  ]
+  signature_overrides = [
+    {
+      signature_id = 1000001
+      action       = "alert"
+    },
+  ]
+}
+
+resource "versadirector_antivirus_profile" "scan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "scan-downloads"
+  action            = "block"
+  direction         = "download"
+  protocols         = ["http", "ftp"]
+}
+
+resource "versadirector_file_filtering_profile" "executables" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "no-executables"
+  rules = [
+    {
+      name       = "block-exe"
+      file_types = ["exe", "msi"]
+      action     = "block"
+    },
+  ]
+}
+
+data "versadirector_ips_profile" "strict" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = versadirector_ips_profile.strict.name
+}
+
+resource "versadirector_security_rule" "allow_web" {
+  device_name            = "devicename"
+  organization_name      = "orgname"
+  policy_name            = "Default-Policy"
+  name                   = "allow-web"
+  services               = ["http", "https"]
+  action                 = "allow"
+  url_filtering_profile  = versadirector_url_filtering_profile.corporate.name
+  ips_profile            = data.versadirector_ips_profile.strict.name
+  antivirus_profile      = versadirector_antivirus_profile.scan.name
+  file_filtering_profile = versadirector_file_filtering_profile.executables.name
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing services can be imported with:
+#   terraform import versadirector_service.https_alt devicename/orgname/https-alt
+resource "versadirector_service" "https_alt" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "https-alt"
+  protocol          = "tcp"
+  destination_port  = "8443"
+}
+
+resource "versadirector_service" "ping" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "ping"
+  protocol          = "icmp"
+  icmp_type         = 8
+}
+
+resource "versadirector_service_group" "web" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "web"
+  services          = ["http", versadirector_service.https_alt.name]
+}
+
+data "versadirector_service_group" "web" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = versadirector_service_group.web.name
+}
+
+output "web_services" {
+  value = data.versadirector_service_group.web.services
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    versadirector = {
+      source = "versa-networks.com/versa-networks/versadirector"
+    }
+  }
+}
+
+provider "versadirector" {
+  username = "username"
+  password = "password"
+  host     = "10.20.30.40"
+  port     = "9182"
+  #always to be set oauth_grant_type = "password"
+  oauth_grant_type    = "password"
+  oauth_client_id     = "XXXXXXXXXXXXXXXX"
+  oauth_client_secret = "YYYYYYYYYYYYYYYY"
+}
+
+# Existing zones can be imported with:
+#   terraform import versadirector_zone.lan devicename/orgname/lan
+resource "versadirector_zone" "lan" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  name              = "lan"
+  interfaces        = ["vni-0/2.0"]
+  networks          = ["LAN1"]
+}
+
+data "versadirector_zones" "all" {
+  device_name       = "devicename"
+  organization_name = "orgname"
+  depends_on        = [versadirector_zone.lan]
+}
+
+output "zone_names" {
+  value = [for zone in data.versadirector_zones.all.zones : zone.name]
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAddressGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_address" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-address-1"
+  ipv4_prefix       = "10.1.0.0/16"
+}
+
+resource "versadirector_address_group" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-group-1"
+  addresses         = [versadirector_address.test.name]
+}
+
+data "versadirector_address_group" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = versadirector_address_group.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_address_group.test", "id", "Branch-1/Customer-1/versa-networks-group-1"),
+					resource.TestCheckResourceAttr("versadirector_address_group.test", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.versadirector_address_group.test", "addresses.0", "versa-networks-address-1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_address_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAddressGroupResourceMissingAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_address_group" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-group-1"
+  addresses         = ["versa-networks-missing-address"]
+}
+`,
+				// Plan only warns as the address could be created by the same
+				// apply, director rejects the group
+				ExpectError: regexp.MustCompile("Error Creating Address Group"),
+			},
+		},
+	})
+}
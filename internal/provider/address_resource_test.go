@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAddressObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_address" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-address-1"
+  ipv4_prefix       = "10.1.0.0/16"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_address.test", "id", "Branch-1/Customer-1/versa-networks-address-1"),
+					resource.TestCheckResourceAttr("versadirector_address.test", "ipv4_prefix", "10.1.0.0/16"),
+					resource.TestCheckResourceAttrSet("versadirector_address.test", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_address.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_address" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-address-1"
+  fqdn              = "versa-networks.com"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_address.test", "fqdn", "versa-networks.com"),
+					resource.TestCheckNoResourceAttr("versadirector_address.test", "ipv4_prefix"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
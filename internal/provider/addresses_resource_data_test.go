@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"versa-networks.com/vclient"
 )
 
 func TestAccOrderResource(t *testing.T) {
@@ -73,3 +78,96 @@ resource "versadirector_addresses" "test" {
 		},
 	})
 }
+
+func TestAccAddressesResourceConflictingTypes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_addresses" "test" {
+  device_name = "Branch-1"
+  organization_name = "Customer-1"
+  address = [
+    {
+      name = "versa-networks-addresses-1"
+      fqdn = "versa-networks.com"
+      ipv4_prefix = "10.1.0.0/16"
+    }
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Conflicting Attributes"),
+			},
+		},
+	})
+}
+
+func TestAccAddressesResourceExclusive(t *testing.T) {
+	config := func(exclusive bool) string {
+		return providerConfig + fmt.Sprintf(`
+resource "versadirector_addresses" "test" {
+  device_name = "Branch-1"
+  organization_name = "Customer-1"
+  exclusive = %v
+  address = [
+    {
+      name = "versa-networks-addresses-1"
+      ipv4_prefix = "10.1.0.0/16"
+    }
+  ]
+}
+`, exclusive)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_addresses.test", "exclusive", "false"),
+					resource.TestCheckResourceAttr("versadirector_addresses.test", "address.#", "1"),
+				),
+			},
+			// Address created outside Terraform is removed once exclusive is set
+			{
+				PreConfig: func() {
+					err := testAccClient(t).CreateDevOrgServiceObjAddresses(context.Background(),
+						vclient.DevOjectsAddressListData{AddrList: vclient.DevObjectsAddressList{
+							DeviceName:       "Branch-1",
+							OrganizationName: "Customer-1",
+							Count:            1,
+							Addresses: []vclient.DevObjectAddress{
+								{Name: "versa-networks-unmanaged-1", IPv4Prefix: "10.2.0.0/16"},
+							},
+						}})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_addresses.test", "exclusive", "true"),
+					resource.TestCheckResourceAttr("versadirector_addresses.test", "address.#", "1"),
+					testAccCheckAddressDeleted(t, "Branch-1", "Customer-1", "versa-networks-unmanaged-1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckAddressDeleted checks the address is gone from director.
+func testAccCheckAddressDeleted(t *testing.T, deviceName string, organizationName string,
+	name string) func(*terraform.State) error {
+
+	return func(*terraform.State) error {
+		_, err := testAccClient(t).GetDeviceOrganizationAddress(context.Background(),
+			deviceName, organizationName, name)
+		if err == nil {
+			return fmt.Errorf("address %v still exists", name)
+		} else if !vclient.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+}
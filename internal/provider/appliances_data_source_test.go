@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppliancesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "versadirector_appliances" "test" {
+	name = "Branch-1"
+}
+data "versadirector_organizations" "test" {
+	name = "ACME"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.versadirector_appliances.test", "appliances.#", "1"),
+					resource.TestCheckResourceAttr("data.versadirector_appliances.test", "appliances.0.name", "Branch-1"),
+					resource.TestCheckResourceAttrSet("data.versadirector_appliances.test", "appliances.0.uuid"),
+					resource.TestCheckResourceAttr("data.versadirector_organizations.test", "organizations.#", "1"),
+					resource.TestCheckResourceAttr("data.versadirector_organizations.test", "organizations.0.name", "ACME"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomApplicationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_custom_application" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-app-1"
+  risk              = 2
+  rules = [
+    {
+      name         = "web"
+      host_pattern = ".*\\.example\\.com"
+    },
+  ]
+}
+
+resource "versadirector_custom_url_category" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-category-1"
+  urls              = ["www.example.com"]
+}
+
+data "versadirector_applications" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_custom_application.test", "rules.0.name", "web"),
+					resource.TestCheckResourceAttr("versadirector_custom_url_category.test", "urls.0", "www.example.com"),
+					resource.TestCheckResourceAttrSet("data.versadirector_applications.test", "ids.#"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_custom_application.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecurityRuleResourceUnknownApplication(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_security_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = "Default-Policy"
+  name              = "versa-networks-rule-1"
+  applications      = ["NO-SUCH-APPLICATION"]
+  action            = "deny"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Referenced Object"),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"versa-networks.com/vclient"
+)
+
+func TestAccNATResources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_nat_pool" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-pool-1"
+  prefixes          = ["192.0.2.0/28"]
+  port_range        = "1024-65535"
+  routing_instance  = "Internet-Transport-VR"
+}
+
+resource "versadirector_nat_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-1"
+  source_zones      = ["LAN"]
+  source_prefixes   = ["10.1.0.0/16"]
+  translation_type  = "napt-44"
+  pool              = versadirector_nat_pool.test.name
+  position          = "top"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_nat_rule.test", "id",
+						"Branch-1/Customer-1/versa-networks-nat-1"),
+					resource.TestCheckResourceAttr("versadirector_nat_rule.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("versadirector_nat_rule.test", "translation_type", "napt-44"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_nat_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "position", "relative_to"},
+			},
+			{
+				ResourceName:            "versadirector_nat_pool.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Rule ordering testing
+			{
+				Config: providerConfig + `
+resource "versadirector_nat_pool" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-pool-1"
+  prefixes          = ["192.0.2.0/28"]
+  port_range        = "1024-65535"
+  routing_instance  = "Internet-Transport-VR"
+}
+
+resource "versadirector_nat_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-1"
+  source_zones      = ["LAN"]
+  source_prefixes   = ["10.1.0.0/16"]
+  translation_type  = "napt-44"
+  pool              = versadirector_nat_pool.test.name
+  position          = "top"
+}
+
+resource "versadirector_nat_rule" "before" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-0"
+  source_prefixes   = ["10.2.0.0/16"]
+  translation_type  = "napt-44"
+  pool              = versadirector_nat_pool.test.name
+  position          = "before"
+  relative_to       = versadirector_nat_rule.test.name
+}
+
+resource "versadirector_nat_rule" "after" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-2"
+  source_prefixes   = ["10.3.0.0/16"]
+  translation_type  = "napt-44"
+  pool              = versadirector_nat_pool.test.name
+  position          = "after"
+  relative_to       = versadirector_nat_rule.test.name
+}
+`,
+				Check: testAccCheckRuleOrder(t, func(ctx context.Context, client *vclient.Client) ([]string, error) {
+					rules, err := client.GetDeviceOrganizationNATRules(ctx, "Branch-1", "Customer-1")
+					var names []string
+					for _, val := range rules {
+						names = append(names, val.Name)
+					}
+					return names, err
+				}, "versa-networks-nat-0", "versa-networks-nat-1", "versa-networks-nat-2"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNATResourcesInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_nat_pool" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-pool-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`One of prefixes or ranges must be set`),
+			},
+			{
+				Config: providerConfig + `
+resource "versadirector_nat_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-1"
+  translation_type  = "dnat"
+  pool              = "versa-networks-pool-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`destination_prefixes must be set`),
+			},
+			{
+				Config: providerConfig + `
+resource "versadirector_nat_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-nat-1"
+  source_prefixes   = ["10.1.0.300/16"]
+  translation_type  = "napt-44"
+  pool              = "versa-networks-pool-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &addressGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &addressGroupDataSource{}
+)
+
+// NewAddressGroupDataSource is a helper function to simplify the provider implementation.
+func NewAddressGroupDataSource() datasource.DataSource {
+	return &addressGroupDataSource{}
+}
+
+// addressGroupDataSource is the data source implementation.
+type addressGroupDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *addressGroupDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_address_group"
+}
+
+// Schema defines the schema for the data source.
+func (d *addressGroupDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the address group in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the address group.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the address group.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the address group.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the address group.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the address group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"addresses": schema.ListAttribute{
+				Description: "Names of address objects in the group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"address_groups": schema.ListAttribute{
+				Description: "Names of nested address groups.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"address_files": schema.ListAttribute{
+				Description: "Names of address files in the group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *addressGroupDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *addressGroupDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config addressGroupDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get Address group "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	group, err := d.client.GetDeviceOrganizationAddressGroup(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Address Group "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = stringValueOrNull(group.Description)
+	config.Tags = stringListValue(group.Tags, nil)
+	config.Addresses = stringListValue(group.Addresses, nil)
+	config.AddressGroups = stringListValue(group.AddressGroups, nil)
+	config.AddressFiles = stringListValue(group.AddressFiles, nil)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// addressGroupDataModel maps the data source schema data.
+type addressGroupDataModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Addresses        []types.String `tfsdk:"addresses"`
+	AddressGroups    []types.String `tfsdk:"address_groups"`
+	AddressFiles     []types.String `tfsdk:"address_files"`
+}
@@ -68,10 +68,59 @@ func (d *addressesDataSource) Schema(ctx context.Context,
 							Description: "Name of the address object.",
 							Optional:    true,
 						},
+						"description": schema.StringAttribute{
+							Description: "Description of the address object.",
+							Optional:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags of the address object.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
 						"fqdn": schema.StringAttribute{
 							Description: "FQDN for the address object.",
 							Optional:    true,
 						},
+						"ipv4_prefix": schema.StringAttribute{
+							Description: "IPv4 prefix for the address object.",
+							Optional:    true,
+						},
+						"ipv6_prefix": schema.StringAttribute{
+							Description: "IPv6 prefix for the address object.",
+							Optional:    true,
+						},
+						"ipv4_range": schema.SingleNestedAttribute{
+							Description: "IPv4 address range for the address object.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"start": schema.StringAttribute{
+									Description: "First IPv4 address of the range.",
+									Optional:    true,
+								},
+								"end": schema.StringAttribute{
+									Description: "Last IPv4 address of the range.",
+									Optional:    true,
+								},
+							},
+						},
+						"ipv4_wildcard_mask": schema.SingleNestedAttribute{
+							Description: "IPv4 address with wildcard mask for the address object.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									Description: "IPv4 address.",
+									Optional:    true,
+								},
+								"mask": schema.StringAttribute{
+									Description: "Wildcard mask.",
+									Optional:    true,
+								},
+							},
+						},
+						"dynamic_address": schema.StringAttribute{
+							Description: "Dynamic address for the address object.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -141,8 +190,17 @@ func (d *addressesDataSource) Read(ctx context.Context,
 		" Organization: "+organizationName)
 
 	addrData, err := d.client.GetDeviceOrganizationAddresses(ctx, deviceName, organizationName)
+	if vclient.IsNotFound(err) {
+		/* director answers 404 when organization has no addresses yet */
+		tflog.Debug(ctx, "DATA-READ: No addresses for Device: "+deviceName+
+			" Organization: "+organizationName)
+		addrData, err = &vclient.DevObjectsAddressList{}, nil
+	}
 	if err != nil {
 		tflog.Error(ctx, "Failed to get addresses for device "+deviceName+" Organization "+organizationName)
+		addClientError(&resp.Diagnostics,
+			"Error Reading Addresses for Device "+deviceName+" Organization "+organizationName, err)
+		return
 	} else {
 		if len(deviceName) > 0 {
 			config.DeviceName = types.StringValue(deviceName)
@@ -151,11 +209,8 @@ func (d *addressesDataSource) Read(ctx context.Context,
 			config.OrganizationName = types.StringValue(organizationName)
 		}
 		for _, val := range addrData.Addresses {
-			configAddrData := addressDataModel{
-				Name: types.StringValue(val.Name),
-				FQDN: types.StringValue(val.FQDN),
-			}
-			config.Address = append(config.Address, configAddrData)
+			config.Address = append(config.Address,
+				addressDataModel(addressItemFromClient(val, addressItemModel{})))
 		}
 	}
 	config.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
@@ -176,6 +231,13 @@ type addressesDataModel struct {
 
 // addressItem
 type addressDataModel struct {
-	Name types.String `tfsdk:"name"`
-	FQDN types.String `tfsdk:"fqdn"`
+	Name             types.String          `tfsdk:"name"`
+	Description      types.String          `tfsdk:"description"`
+	Tags             []types.String        `tfsdk:"tags"`
+	FQDN             types.String          `tfsdk:"fqdn"`
+	IPv4Prefix       types.String          `tfsdk:"ipv4_prefix"`
+	IPv6Prefix       types.String          `tfsdk:"ipv6_prefix"`
+	IPv4Range        *addressRangeModel    `tfsdk:"ipv4_range"`
+	IPv4WildcardMask *addressWildcardModel `tfsdk:"ipv4_wildcard_mask"`
+	DynamicAddress   types.String          `tfsdk:"dynamic_address"`
 }
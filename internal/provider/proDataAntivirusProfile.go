@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &antivirusProfileDataSource{}
+	_ datasource.DataSourceWithConfigure = &antivirusProfileDataSource{}
+)
+
+// NewAntivirusProfileDataSource is a helper function to simplify the provider implementation.
+func NewAntivirusProfileDataSource() datasource.DataSource {
+	return &antivirusProfileDataSource{}
+}
+
+// antivirusProfileDataSource is the data source implementation.
+type antivirusProfileDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *antivirusProfileDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_antivirus_profile"
+}
+
+// Schema defines the schema for the data source.
+func (d *antivirusProfileDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the antivirus profile in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the antivirus profile.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the antivirus profile.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the antivirus profile.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Action for infected files, one of " +
+					strings.Join(antivirusActions, ", ") + ".",
+				Computed: true,
+			},
+			"direction": schema.StringAttribute{
+				Description: "Direction of scanned transfers, one of " +
+					strings.Join(transferDirections, ", ") + ".",
+				Computed: true,
+			},
+			"protocols": schema.ListAttribute{
+				Description: "Protocols of scanned transfers, any of " +
+					strings.Join(transferProtocols, ", ") + ".",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"file_types": schema.ListAttribute{
+				Description: "File types to scan, e.g. exe or pdf.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *antivirusProfileDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *antivirusProfileDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config antivirusProfileDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get Antivirus Profile "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	profile, err := d.client.GetDeviceOrganizationAntivirusProfile(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Antivirus Profile "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	// Reuse the resource conversion, the attributes are the same
+	var model antivirusProfileResourceModel
+	model.fromClient(*profile)
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = model.Description
+	config.Tags = model.Tags
+	config.Action = model.Action
+	config.Direction = model.Direction
+	config.Protocols = model.Protocols
+	config.FileTypes = model.FileTypes
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// antivirusProfileDataModel maps the data source schema data.
+type antivirusProfileDataModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Action           types.String   `tfsdk:"action"`
+	Direction        types.String   `tfsdk:"direction"`
+	Protocols        []types.String `tfsdk:"protocols"`
+	FileTypes        []types.String `tfsdk:"file_types"`
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"versa-networks.com/vclient"
 )
 
@@ -35,19 +34,34 @@ func (d *appliancesDataSource) Metadata(_ context.Context,
 }
 
 // Schema defines the schema for the data source.
-func (d *appliancesDataSource) Schema(ctx context.Context,
+func (d *appliancesDataSource) Schema(_ context.Context,
 	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 
-	tflog.Info(ctx, "Schema called....")
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"appliance_name": schema.StringAttribute{
-				Description: "Identifier for this address item.",
-				Required:    true,
+			"name": schema.StringAttribute{
+				Description: "Only return the appliance with this name.",
+				Optional:    true,
 			},
-			"organization_name": schema.StringAttribute{
-				Description: "Org Identifier for this address item.",
-				Required:    true,
+			"uuid": schema.StringAttribute{
+				Description: "Only return the appliance with this unique identifier.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return appliances of this type, e.g. branch or controller.",
+				Optional:    true,
+			},
+			"ping_status": schema.StringAttribute{
+				Description: "Only return appliances with this ping status, e.g. REACHABLE.",
+				Optional:    true,
+			},
+			"sync_status": schema.StringAttribute{
+				Description: "Only return appliances with this sync status, e.g. IN_SYNC.",
+				Optional:    true,
+			},
+			"owner_org": schema.StringAttribute{
+				Description: "Only return appliances owned by this organization.",
+				Optional:    true,
 			},
 			"appliances": schema.ListNestedAttribute{
 				Description: "List of appliances.",
@@ -62,6 +76,35 @@ func (d *appliancesDataSource) Schema(ctx context.Context,
 							Description: "Name of the appliance.",
 							Computed:    true,
 						},
+						"type": schema.StringAttribute{
+							Description: "Type of the appliance.",
+							Computed:    true,
+						},
+						"owner_org": schema.StringAttribute{
+							Description: "Organization owning the appliance.",
+							Computed:    true,
+						},
+						"orgs": schema.ListAttribute{
+							Description: "Organizations configured on the appliance.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "Management IP address of the appliance.",
+							Computed:    true,
+						},
+						"software_version": schema.StringAttribute{
+							Description: "Software version running on the appliance.",
+							Computed:    true,
+						},
+						"ping_status": schema.StringAttribute{
+							Description: "Reachability status of the appliance.",
+							Computed:    true,
+						},
+						"sync_status": schema.StringAttribute{
+							Description: "Configuration sync status of the appliance.",
+							Computed:    true,
+						},
 						"services_status": schema.StringAttribute{
 							Description: "Health status of services in appliance.",
 							Computed:    true,
@@ -102,25 +145,49 @@ func (d *appliancesDataSource) Read(ctx context.Context,
 	req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
 	var state appliancesDataSourceList
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	appliances, err := d.client.GetAllAppliances(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading FlexVNF Appliances,", err.Error())
+		addClientError(&resp.Diagnostics, "Error Reading FlexVNF Appliances", err)
 		return
 	}
 
-	for i := 0; i < appliances.TotalCount; i++ {
+	for _, val := range appliances.Appliances {
+		if !filterStringMatch(state.Name, val.Name) ||
+			!filterStringMatch(state.Uuid, val.UUID) ||
+			!filterStringMatch(state.Type, val.Type) ||
+			!filterStringMatch(state.PingStatus, val.PingStatus) ||
+			!filterStringMatch(state.SyncStatus, val.SyncStatus) ||
+			!filterStringMatch(state.OwnerOrg, val.OwnerOrg) {
+			continue
+		}
+		orgs, diags := types.ListValueFrom(ctx, types.StringType, val.Orgs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		curAppliance := applianceData{
-			Uuid:           types.StringValue(appliances.Appliances[i].UUID),
-			Name:           types.StringValue(appliances.Appliances[i].Name),
-			ServicesStatus: types.StringValue(appliances.Appliances[i].ServicesStatus),
-			OverallStatus:  types.StringValue(appliances.Appliances[i].OverallStatus),
+			Uuid:            types.StringValue(val.UUID),
+			Name:            types.StringValue(val.Name),
+			Type:            types.StringValue(val.Type),
+			OwnerOrg:        types.StringValue(val.OwnerOrg),
+			Orgs:            orgs,
+			IpAddress:       types.StringValue(val.IpAddress),
+			SoftwareVersion: types.StringValue(val.SoftwareVersion),
+			PingStatus:      types.StringValue(val.PingStatus),
+			SyncStatus:      types.StringValue(val.SyncStatus),
+			ServicesStatus:  types.StringValue(val.ServicesStatus),
+			OverallStatus:   types.StringValue(val.OverallStatus),
 		}
 		state.Appliances = append(state.Appliances, curAppliance)
 	}
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -129,15 +196,26 @@ func (d *appliancesDataSource) Read(ctx context.Context,
 
 // appliancesDataSourceList maps the data source schema data.
 type appliancesDataSourceList struct {
-	ApplianceName   types.String    `tfsdk:"appliance_name"`
-	OrganizatioName types.String    `tfsdk:"organization_name"`
-	Appliances      []applianceData `tfsdk:"appliances"`
+	Name       types.String    `tfsdk:"name"`
+	Uuid       types.String    `tfsdk:"uuid"`
+	Type       types.String    `tfsdk:"type"`
+	PingStatus types.String    `tfsdk:"ping_status"`
+	SyncStatus types.String    `tfsdk:"sync_status"`
+	OwnerOrg   types.String    `tfsdk:"owner_org"`
+	Appliances []applianceData `tfsdk:"appliances"`
 }
 
 // applianceData maps appliances schema data.
 type applianceData struct {
-	Uuid           types.String `tfsdk:"uuid"`
-	Name           types.String `tfsdk:"name"`
-	ServicesStatus types.String `tfsdk:"services_status"`
-	OverallStatus  types.String `tfsdk:"overall_status"`
+	Uuid            types.String `tfsdk:"uuid"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	OwnerOrg        types.String `tfsdk:"owner_org"`
+	Orgs            types.List   `tfsdk:"orgs"`
+	IpAddress       types.String `tfsdk:"ip_address"`
+	SoftwareVersion types.String `tfsdk:"software_version"`
+	PingStatus      types.String `tfsdk:"ping_status"`
+	SyncStatus      types.String `tfsdk:"sync_status"`
+	ServicesStatus  types.String `tfsdk:"services_status"`
+	OverallStatus   types.String `tfsdk:"overall_status"`
 }
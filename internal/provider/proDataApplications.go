@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &applicationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &applicationsDataSource{}
+)
+
+// NewApplicationsDataSource is a helper function to simplify the provider implementation.
+func NewApplicationsDataSource() datasource.DataSource {
+	return &applicationsDataSource{}
+}
+
+// applicationsDataSource lists predefined applications of the security
+// pack installed on director.
+type applicationsDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *applicationsDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_applications"
+}
+
+// Schema defines the schema for the data source.
+func (d *applicationsDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"family": schema.StringAttribute{
+				Description: "Only return applications of this family.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "Application IDs as referenced by policies, e.g. FACEBOOK.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"applications": schema.ListNestedAttribute{
+				Description: "List of predefined applications.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Application ID.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the application.",
+							Computed:    true,
+						},
+						"family": schema.StringAttribute{
+							Description: "Application family.",
+							Computed:    true,
+						},
+						"subfamily": schema.StringAttribute{
+							Description: "Application subfamily.",
+							Computed:    true,
+						},
+						"risk": schema.Int64Attribute{
+							Description: "Risk of the application from 1, lowest, to 5.",
+							Computed:    true,
+						},
+						"productivity": schema.Int64Attribute{
+							Description: "Productivity of the application from 1, lowest, to 5.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *applicationsDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *applicationsDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state applicationsDataSourceList
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "DATA-READ: Get Predefined Applications")
+
+	apps, err := d.client.GetAllPredefinedApplications(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading Predefined Applications", err)
+		return
+	}
+	state.IDs = []types.String{}
+	for _, val := range apps {
+		if !filterStringMatch(state.Family, val.Family) {
+			continue
+		}
+		state.IDs = append(state.IDs, types.StringValue(val.Name))
+		state.Applications = append(state.Applications, applicationsData{
+			Name:         types.StringValue(val.Name),
+			Description:  stringValueOrNull(val.Description),
+			Family:       stringValueOrNull(val.Family),
+			SubFamily:    stringValueOrNull(val.SubFamily),
+			Risk:         types.Int64Value(val.Risk),
+			Productivity: types.Int64Value(val.Productivity),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applicationsDataSourceList maps the data source schema data.
+type applicationsDataSourceList struct {
+	Family       types.String       `tfsdk:"family"`
+	IDs          []types.String     `tfsdk:"ids"`
+	Applications []applicationsData `tfsdk:"applications"`
+}
+
+// applicationsData maps applications schema data.
+type applicationsData struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Family       types.String `tfsdk:"family"`
+	SubFamily    types.String `tfsdk:"subfamily"`
+	Risk         types.Int64  `tfsdk:"risk"`
+	Productivity types.Int64  `tfsdk:"productivity"`
+}
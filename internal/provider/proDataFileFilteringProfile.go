@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &fileFilteringProfileDataSource{}
+	_ datasource.DataSourceWithConfigure = &fileFilteringProfileDataSource{}
+)
+
+// NewFileFilteringProfileDataSource is a helper function to simplify the provider implementation.
+func NewFileFilteringProfileDataSource() datasource.DataSource {
+	return &fileFilteringProfileDataSource{}
+}
+
+// fileFilteringProfileDataSource is the data source implementation.
+type fileFilteringProfileDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *fileFilteringProfileDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_file_filtering_profile"
+}
+
+// Schema defines the schema for the data source.
+func (d *fileFilteringProfileDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the file filtering profile in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the file filtering profile.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the file filtering profile.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the file filtering profile.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"default_action": schema.StringAttribute{
+				Description: "Action for files matching no rule, one of " +
+					strings.Join(fileFilteringActions, ", ") + ".",
+				Computed: true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules matching file transfers, evaluated in order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Computed:    true,
+						},
+						"file_types": schema.ListAttribute{
+							Description: "File types matched by the rule, e.g. exe or pdf.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"protocols": schema.ListAttribute{
+							Description: "Protocols matched by the rule, any of " +
+								strings.Join(transferProtocols, ", ") + ".",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction matched by the rule, one of " +
+								strings.Join(transferDirections, ", ") + ".",
+							Computed: true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for matched files, one of " +
+								strings.Join(fileFilteringActions, ", ") + ".",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *fileFilteringProfileDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *fileFilteringProfileDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config fileFilteringProfileDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get File Filtering Profile "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	profile, err := d.client.GetDeviceOrganizationFileFilteringProfile(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading File Filtering Profile "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	// Reuse the resource conversion, the attributes are the same
+	var model fileFilteringProfileResourceModel
+	model.fromClient(*profile)
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = model.Description
+	config.Tags = model.Tags
+	config.DefaultAction = model.DefaultAction
+	config.Rules = model.Rules
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// fileFilteringProfileDataModel maps the data source schema data.
+type fileFilteringProfileDataModel struct {
+	ID               types.String             `tfsdk:"id"`
+	DeviceName       types.String             `tfsdk:"device_name"`
+	OrganizationName types.String             `tfsdk:"organization_name"`
+	Name             types.String             `tfsdk:"name"`
+	Description      types.String             `tfsdk:"description"`
+	Tags             []types.String           `tfsdk:"tags"`
+	DefaultAction    types.String             `tfsdk:"default_action"`
+	Rules            []fileFilteringRuleModel `tfsdk:"rules"`
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ipsProfileDataSource{}
+	_ datasource.DataSourceWithConfigure = &ipsProfileDataSource{}
+)
+
+// NewIPSProfileDataSource is a helper function to simplify the provider implementation.
+func NewIPSProfileDataSource() datasource.DataSource {
+	return &ipsProfileDataSource{}
+}
+
+// ipsProfileDataSource is the data source implementation.
+type ipsProfileDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *ipsProfileDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_ips_profile"
+}
+
+// Schema defines the schema for the data source.
+func (d *ipsProfileDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the IPS profile in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the IPS profile.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the IPS profile.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the IPS profile.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules selecting signatures by severity, evaluated in order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Computed:    true,
+						},
+						"severities": schema.ListAttribute{
+							Description: "Severities of matched signatures, any of " +
+								strings.Join(ipsSeverities, ", ") + ".",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for traffic matching the signatures, one of " +
+								strings.Join(ipsActions, ", ") + ".",
+							Computed: true,
+						},
+					},
+				},
+			},
+			"signature_overrides": schema.ListNestedAttribute{
+				Description: "Actions of single signatures, overriding the rules.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"signature_id": schema.Int64Attribute{
+							Description: "Identifier of the signature.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for traffic matching the signature, one of " +
+								strings.Join(ipsActions, ", ") + ".",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ipsProfileDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ipsProfileDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config ipsProfileDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get IPS Profile "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	profile, err := d.client.GetDeviceOrganizationIPSProfile(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading IPS Profile "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	// Reuse the resource conversion, the attributes are the same
+	var model ipsProfileResourceModel
+	model.fromClient(*profile)
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = model.Description
+	config.Tags = model.Tags
+	config.Rules = model.Rules
+	config.SignatureOverrides = model.SignatureOverrides
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ipsProfileDataModel maps the data source schema data.
+type ipsProfileDataModel struct {
+	ID                 types.String                `tfsdk:"id"`
+	DeviceName         types.String                `tfsdk:"device_name"`
+	OrganizationName   types.String                `tfsdk:"organization_name"`
+	Name               types.String                `tfsdk:"name"`
+	Description        types.String                `tfsdk:"description"`
+	Tags               []types.String              `tfsdk:"tags"`
+	Rules              []ipsRuleModel              `tfsdk:"rules"`
+	SignatureOverrides []ipsSignatureOverrideModel `tfsdk:"signature_overrides"`
+}
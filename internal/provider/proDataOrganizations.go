@@ -40,6 +40,18 @@ func (d *organizationsDataSource) Schema(_ context.Context,
 
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return the organization with this name.",
+				Optional:    true,
+			},
+			"uuid": schema.StringAttribute{
+				Description: "Only return the organization with this unique identifier.",
+				Optional:    true,
+			},
+			"parent": schema.StringAttribute{
+				Description: "Only return organizations with this parent organization.",
+				Optional:    true,
+			},
 			"organizations": schema.ListNestedAttribute{
 				Description: "List of organizations in appliance.",
 				Computed:    true,
@@ -57,10 +69,18 @@ func (d *organizationsDataSource) Schema(_ context.Context,
 							Description: "Name of the organization.",
 							Computed:    true,
 						},
+						"parent": schema.StringAttribute{
+							Description: "Parent of the organization.",
+							Computed:    true,
+						},
 						"subscription_plan": schema.StringAttribute{
 							Description: "Subscription plan for organization.",
 							Computed:    true,
 						},
+						"cpe_deployment_type": schema.StringAttribute{
+							Description: "CPE deployment type for organization.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -95,25 +115,35 @@ func (d *organizationsDataSource) Read(ctx context.Context,
 	tflog.Info(ctx, "Read organizations data")
 
 	var state organizationsDataSourceList
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	organizations, err := d.client.GetAllOrganizations(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Organizations,", err.Error())
+		addClientError(&resp.Diagnostics, "Error Reading Organizations", err)
 		return
 	}
 	for _, val := range organizations {
+		if !filterStringMatch(state.Name, val.Name) ||
+			!filterStringMatch(state.Uuid, val.UUID) ||
+			!filterStringMatch(state.Parent, val.Parent) {
+			continue
+		}
 		curOrganization := organizationsData{
-			Id:               types.Int64Value(int64(val.Id)),
-			Uuid:             types.StringValue(val.UUID),
-			Name:             types.StringValue(val.Name),
-			SubscriptionPlan: types.StringValue(val.SubscriptionPlan),
+			Id:                types.Int64Value(int64(val.Id)),
+			Uuid:              types.StringValue(val.UUID),
+			Name:              types.StringValue(val.Name),
+			Parent:            types.StringValue(val.Parent),
+			SubscriptionPlan:  types.StringValue(val.SubscriptionPlan),
+			CpeDeploymentType: types.StringValue(val.CpeDeploymentType),
 		}
-		tflog.Info(ctx, "Updating org data")
+		tflog.Debug(ctx, "Updating org data for "+val.Name)
 		state.Organizations = append(state.Organizations, curOrganization)
-
 	}
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -122,13 +152,18 @@ func (d *organizationsDataSource) Read(ctx context.Context,
 
 // organizationsDataSourceList maps the data source schema data.
 type organizationsDataSourceList struct {
+	Name          types.String        `tfsdk:"name"`
+	Uuid          types.String        `tfsdk:"uuid"`
+	Parent        types.String        `tfsdk:"parent"`
 	Organizations []organizationsData `tfsdk:"organizations"`
 }
 
 // organizationsData maps Organizations schema data.
 type organizationsData struct {
-	Id               types.Int64  `tfsdk:"id"`
-	Uuid             types.String `tfsdk:"uuid"`
-	Name             types.String `tfsdk:"name"`
-	SubscriptionPlan types.String `tfsdk:"subscription_plan"`
+	Id                types.Int64  `tfsdk:"id"`
+	Uuid              types.String `tfsdk:"uuid"`
+	Name              types.String `tfsdk:"name"`
+	Parent            types.String `tfsdk:"parent"`
+	SubscriptionPlan  types.String `tfsdk:"subscription_plan"`
+	CpeDeploymentType types.String `tfsdk:"cpe_deployment_type"`
 }
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceDataSource{}
+)
+
+// NewServiceDataSource is a helper function to simplify the provider implementation.
+func NewServiceDataSource() datasource.DataSource {
+	return &serviceDataSource{}
+}
+
+// serviceDataSource is the data source implementation.
+type serviceDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *serviceDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the service in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the service.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the service.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the service.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the service.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the service.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Protocol of the service.",
+				Computed:    true,
+			},
+			"protocol_number": schema.Int64Attribute{
+				Description: "IP protocol number of the service.",
+				Computed:    true,
+			},
+			"source_port": schema.StringAttribute{
+				Description: "Source port or port range.",
+				Computed:    true,
+			},
+			"destination_port": schema.StringAttribute{
+				Description: "Destination port or port range.",
+				Computed:    true,
+			},
+			"icmp_type": schema.Int64Attribute{
+				Description: "ICMP type matched by the service.",
+				Computed:    true,
+			},
+			"icmp_code": schema.Int64Attribute{
+				Description: "ICMP code matched by the service.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config serviceDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get Service "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	service, err := d.client.GetDeviceOrganizationService(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Service "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	// Reuse the resource conversion, the attributes are the same
+	var model serviceResourceModel
+	model.fromClient(*service)
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = model.Description
+	config.Tags = model.Tags
+	config.Protocol = model.Protocol
+	config.ProtocolNumber = model.ProtocolNumber
+	config.SourcePort = model.SourcePort
+	config.DestinationPort = model.DestinationPort
+	config.ICMPType = model.ICMPType
+	config.ICMPCode = model.ICMPCode
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// serviceDataModel maps the data source schema data.
+type serviceDataModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Protocol         types.String   `tfsdk:"protocol"`
+	ProtocolNumber   types.Int64    `tfsdk:"protocol_number"`
+	SourcePort       types.String   `tfsdk:"source_port"`
+	DestinationPort  types.String   `tfsdk:"destination_port"`
+	ICMPType         types.Int64    `tfsdk:"icmp_type"`
+	ICMPCode         types.Int64    `tfsdk:"icmp_code"`
+}
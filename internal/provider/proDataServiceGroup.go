@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceGroupDataSource{}
+)
+
+// NewServiceGroupDataSource is a helper function to simplify the provider implementation.
+func NewServiceGroupDataSource() datasource.DataSource {
+	return &serviceGroupDataSource{}
+}
+
+// serviceGroupDataSource is the data source implementation.
+type serviceGroupDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *serviceGroupDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_service_group"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceGroupDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the service group in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the service group.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the service group.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the service group.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the service group.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the service group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"services": schema.ListAttribute{
+				Description: "Names of services in the group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"service_groups": schema.ListAttribute{
+				Description: "Names of nested service groups.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceGroupDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceGroupDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config serviceGroupDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get Service group "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	group, err := d.client.GetDeviceOrganizationServiceGroup(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Service Group "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = stringValueOrNull(group.Description)
+	config.Tags = stringListValue(group.Tags, nil)
+	config.Services = stringListValue(group.Services, nil)
+	config.ServiceGroups = stringListValue(group.ServiceGroups, nil)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// serviceGroupDataModel maps the data source schema data.
+type serviceGroupDataModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Services         []types.String `tfsdk:"services"`
+	ServiceGroups    []types.String `tfsdk:"service_groups"`
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &urlFilteringProfileDataSource{}
+	_ datasource.DataSourceWithConfigure = &urlFilteringProfileDataSource{}
+)
+
+// NewURLFilteringProfileDataSource is a helper function to simplify the provider implementation.
+func NewURLFilteringProfileDataSource() datasource.DataSource {
+	return &urlFilteringProfileDataSource{}
+}
+
+// urlFilteringProfileDataSource is the data source implementation.
+type urlFilteringProfileDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *urlFilteringProfileDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_url_filtering_profile"
+}
+
+// Schema defines the schema for the data source.
+func (d *urlFilteringProfileDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the URL filtering profile in device/organization/name form.",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the URL filtering profile.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the URL filtering profile.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the URL filtering profile.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"default_action": schema.StringAttribute{
+				Description: "Action for URLs matching no list or category action, one of " +
+					strings.Join(urlFilteringActions, ", ") + ".",
+				Computed: true,
+			},
+			"category_actions": schema.ListNestedAttribute{
+				Description: "Actions for URL categories.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the category action.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for URLs of the categories, one of " +
+								strings.Join(urlFilteringActions, ", ") + ".",
+							Computed: true,
+						},
+						"url_categories": schema.ListAttribute{
+							Description: "Predefined URL categories.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"custom_url_categories": schema.ListAttribute{
+							Description: "Custom URL categories.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"allow_list": schema.ListAttribute{
+				Description: "URL patterns which are always allowed.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"deny_list": schema.ListAttribute{
+				Description: "URL patterns which are always blocked.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *urlFilteringProfileDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *urlFilteringProfileDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var config urlFilteringProfileDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := config.DeviceName.ValueString()
+	organizationName := config.OrganizationName.ValueString()
+	name := config.Name.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get URL Filtering Profile "+name+" for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	profile, err := d.client.GetDeviceOrganizationURLFilteringProfile(ctx, deviceName, organizationName, name)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading URL Filtering Profile "+name+" for Device "+deviceName+
+				" Organization "+organizationName, err)
+		return
+	}
+
+	// Reuse the resource conversion, the attributes are the same
+	var model urlFilteringProfileResourceModel
+	model.fromClient(*profile)
+
+	config.ID = types.StringValue(devOrgObjectID(deviceName, organizationName, name))
+	config.Description = model.Description
+	config.Tags = model.Tags
+	config.DefaultAction = model.DefaultAction
+	config.CategoryActions = model.CategoryActions
+	config.AllowList = model.AllowList
+	config.DenyList = model.DenyList
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// urlFilteringProfileDataModel maps the data source schema data.
+type urlFilteringProfileDataModel struct {
+	ID               types.String                      `tfsdk:"id"`
+	DeviceName       types.String                      `tfsdk:"device_name"`
+	OrganizationName types.String                      `tfsdk:"organization_name"`
+	Name             types.String                      `tfsdk:"name"`
+	Description      types.String                      `tfsdk:"description"`
+	Tags             []types.String                    `tfsdk:"tags"`
+	DefaultAction    types.String                      `tfsdk:"default_action"`
+	CategoryActions  []urlFilteringCategoryActionModel `tfsdk:"category_actions"`
+	AllowList        []types.String                    `tfsdk:"allow_list"`
+	DenyList         []types.String                    `tfsdk:"deny_list"`
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &zonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &zonesDataSource{}
+)
+
+// NewZonesDataSource is a helper function to simplify the provider implementation.
+func NewZonesDataSource() datasource.DataSource {
+	return &zonesDataSource{}
+}
+
+// zonesDataSource is the data source implementation.
+type zonesDataSource struct {
+	client *vclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *zonesDataSource) Metadata(_ context.Context,
+	req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+// Schema defines the schema for the data source.
+func (d *zonesDataSource) Schema(_ context.Context,
+	_ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"device_name": schema.StringAttribute{
+				Description: "Device name of the zones.",
+				Required:    true,
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name of the zones.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return the zone with this name.",
+				Optional:    true,
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "List of zones of the device organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the zone.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the zone.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags of the zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"interfaces": schema.ListAttribute{
+							Description: "Names of member interfaces of the zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"networks": schema.ListAttribute{
+							Description: "Names of member networks of the zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"routing_instances": schema.ListAttribute{
+							Description: "Names of member routing instances of the zone.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *zonesDataSource) Configure(_ context.Context,
+	req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+
+	d.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zonesDataSource) Read(ctx context.Context,
+	req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state zonesDataSourceList
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := state.DeviceName.ValueString()
+	organizationName := state.OrganizationName.ValueString()
+
+	tflog.Debug(ctx, "DATA-READ: Get Zones for Device: "+deviceName+
+		" Organization: "+organizationName)
+
+	zones, err := d.client.GetDeviceOrganizationZones(ctx, deviceName, organizationName)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Zones for Device "+deviceName+" Organization "+organizationName, err)
+		return
+	}
+	for _, val := range zones {
+		if !filterStringMatch(state.Name, val.Name) {
+			continue
+		}
+		state.Zones = append(state.Zones, zonesData{
+			Name:             types.StringValue(val.Name),
+			Description:      stringValueOrNull(val.Description),
+			Tags:             stringListValue(val.Tags, nil),
+			Interfaces:       stringListValue(val.Interfaces, nil),
+			Networks:         stringListValue(val.Networks, nil),
+			RoutingInstances: stringListValue(val.RoutingInstances, nil),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// zonesDataSourceList maps the data source schema data.
+type zonesDataSourceList struct {
+	DeviceName       types.String `tfsdk:"device_name"`
+	OrganizationName types.String `tfsdk:"organization_name"`
+	Name             types.String `tfsdk:"name"`
+	Zones            []zonesData  `tfsdk:"zones"`
+}
+
+// zonesData maps zones schema data.
+type zonesData struct {
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Interfaces       []types.String `tfsdk:"interfaces"`
+	Networks         []types.String `tfsdk:"networks"`
+	RoutingInstances []types.String `tfsdk:"routing_instances"`
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &addressObjectResource{}
+	_ resource.ResourceWithConfigure      = &addressObjectResource{}
+	_ resource.ResourceWithImportState    = &addressObjectResource{}
+	_ resource.ResourceWithValidateConfig = &addressObjectResource{}
+)
+
+// NewAddressObjectResource is a helper function to simplify the provider implementation.
+func NewAddressObjectResource() resource.Resource {
+	return &addressObjectResource{}
+}
+
+// addressObjectResource manages a single address object of a device
+// organization.
+type addressObjectResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *addressObjectResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *addressObjectResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_address"
+}
+
+// Schema defines the schema for the resource.
+func (r *addressObjectResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: addressObjectAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the address object in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the address object.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig rejects address objects with more than one address type.
+func (r *addressObjectResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config addressObjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if set := config.item().typeAttributes(); len(set) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root(set[1]),
+			"Conflicting Attributes",
+			"Only one of "+strings.Join(addressTypeAttributes, ", ")+
+				" can be set, got: "+strings.Join(set, ", "))
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *addressObjectResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *addressObjectResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Address object request received")
+
+	var plan addressObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addrListData := addressListData(plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), []addressItemModel{plan.item()})
+
+	if err := r.client.CreateDevOrgServiceObjAddresses(ctx, addrListData); err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Address "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Address object request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *addressObjectResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Address object request received")
+
+	var state addressObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	address, err := r.client.GetDeviceOrganizationAddress(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Address object "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Address "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.setItem(addressItemFromClient(*address, state.item()))
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Address object request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *addressObjectResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Address object request received")
+
+	var plan addressObjectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addrListData := addressListData(plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), []addressItemModel{plan.item()})
+
+	if _, err := r.client.UpdateDevOrgServiceObjAddresses(ctx, addrListData); err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Address "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Address object request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *addressObjectResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Address object request received")
+
+	var state addressObjectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	addrListData := addressListData(state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), []addressItemModel{state.item()})
+
+	if _, err := r.client.DeleteDevOrgServiceObjAddresses(ctx, addrListData); err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Address "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Address object request completed")
+}
+
+// addressObjectResourceModel maps the resource schema data.
+type addressObjectResourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	DeviceName       types.String          `tfsdk:"device_name"`
+	OrganizationName types.String          `tfsdk:"organization_name"`
+	LastUpdated      types.String          `tfsdk:"last_updated"`
+	Name             types.String          `tfsdk:"name"`
+	Description      types.String          `tfsdk:"description"`
+	Tags             []types.String        `tfsdk:"tags"`
+	FQDN             types.String          `tfsdk:"fqdn"`
+	IPv4Prefix       types.String          `tfsdk:"ipv4_prefix"`
+	IPv6Prefix       types.String          `tfsdk:"ipv6_prefix"`
+	IPv4Range        *addressRangeModel    `tfsdk:"ipv4_range"`
+	IPv4WildcardMask *addressWildcardModel `tfsdk:"ipv4_wildcard_mask"`
+	DynamicAddress   types.String          `tfsdk:"dynamic_address"`
+}
+
+// id builds the device/organization/name resource identifier.
+func (m addressObjectResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// item returns the address object attributes as a list item.
+func (m addressObjectResourceModel) item() addressItemModel {
+	return addressItemModel{
+		Name:             m.Name,
+		Description:      m.Description,
+		Tags:             m.Tags,
+		FQDN:             m.FQDN,
+		IPv4Prefix:       m.IPv4Prefix,
+		IPv6Prefix:       m.IPv6Prefix,
+		IPv4Range:        m.IPv4Range,
+		IPv4WildcardMask: m.IPv4WildcardMask,
+		DynamicAddress:   m.DynamicAddress,
+	}
+}
+
+// setItem copies the address object attributes from a list item.
+func (m *addressObjectResourceModel) setItem(item addressItemModel) {
+	m.Name = item.Name
+	m.Description = item.Description
+	m.Tags = item.Tags
+	m.FQDN = item.FQDN
+	m.IPv4Prefix = item.IPv4Prefix
+	m.IPv6Prefix = item.IPv6Prefix
+	m.IPv4Range = item.IPv4Range
+	m.IPv4WildcardMask = item.IPv4WildcardMask
+	m.DynamicAddress = item.DynamicAddress
+}
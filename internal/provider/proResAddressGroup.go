@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &addressGroupResource{}
+	_ resource.ResourceWithConfigure   = &addressGroupResource{}
+	_ resource.ResourceWithImportState = &addressGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &addressGroupResource{}
+)
+
+// NewAddressGroupResource is a helper function to simplify the provider implementation.
+func NewAddressGroupResource() resource.Resource {
+	return &addressGroupResource{}
+}
+
+// addressGroupResource manages an address group of a device organization.
+type addressGroupResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *addressGroupResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *addressGroupResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_address_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *addressGroupResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the address group in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the address group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the address group.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the address group.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"addresses": schema.ListAttribute{
+				Description: "Names of address objects in the group, they must exist on the device or be created earlier in the same apply.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"address_groups": schema.ListAttribute{
+				Description: "Names of nested address groups, they must exist on the device or be created earlier in the same apply.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"address_files": schema.ListAttribute{
+				Description: "Names of address files in the group.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan checks addresses and nested groups referenced by the group
+// exist on the device, so misspelled members show up at plan time instead
+// of by director half-way through an apply. Members may be created by the
+// same apply, so missing ones are reported as warnings.
+func (r *addressGroupResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+
+	if req.Plan.Raw.IsNull() || r.client == nil ||
+		(!req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw)) {
+		return
+	}
+
+	var deviceName, organizationName, name types.String
+	var addresses, groups types.List
+	for attribute, target := range map[string]any{
+		"device_name":       &deviceName,
+		"organization_name": &organizationName,
+		"name":              &name,
+		"addresses":         &addresses,
+		"address_groups":    &groups,
+	} {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), target)...)
+	}
+	if resp.Diagnostics.HasError() || deviceName.IsUnknown() || organizationName.IsUnknown() ||
+		name.IsUnknown() {
+		return
+	}
+	device := deviceName.ValueString()
+	organization := organizationName.ValueString()
+
+	if refs := groupMembers(ctx, addresses, &resp.Diagnostics); len(refs) > 0 {
+		// Director answers 404 when the organization has no addresses yet
+		addrData, err := r.client.GetDeviceOrganizationAddresses(ctx, device, organization)
+		if err != nil && !vclient.IsNotFound(err) {
+			addClientError(&resp.Diagnostics, "Error Reading Addresses for Device "+device+
+				" Organization "+organization, err)
+			return
+		}
+		var names []string
+		if addrData != nil {
+			for _, val := range addrData.Addresses {
+				names = append(names, val.Name)
+			}
+		}
+		addMissingMembers(&resp.Diagnostics, path.Root("addresses"), "Address", refs, names)
+	}
+
+	if refs := groupMembers(ctx, groups, &resp.Diagnostics); len(refs) > 0 {
+		groupData, err := r.client.GetDeviceOrganizationAddressGroups(ctx, device, organization)
+		if err != nil && !vclient.IsNotFound(err) {
+			addClientError(&resp.Diagnostics, "Error Reading Address Groups for Device "+device+
+				" Organization "+organization, err)
+			return
+		}
+		var names []string
+		for _, val := range groupData {
+			if val.Name != name.ValueString() {
+				names = append(names, val.Name)
+			}
+		}
+		addMissingMembers(&resp.Diagnostics, path.Root("address_groups"), "Address group", refs, names)
+	}
+}
+
+// groupMembers returns the members of a planned list attribute, unknown
+// members are kept so that indexes match the configuration.
+func groupMembers(ctx context.Context, list types.List, diags *diag.Diagnostics) []types.String {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	var refs []types.String
+	diags.Append(list.ElementsAs(ctx, &refs, false)...)
+	return refs
+}
+
+// addMissingMembers warns about members which are not part of names, the
+// objects configured on the device. Apply fails unless they are created
+// before the group.
+func addMissingMembers(diags *diag.Diagnostics, attrPath path.Path,
+	kind string, refs []types.String, names []string) {
+
+	for _, key := range missingReferences(refs, names) {
+		diags.AddAttributeWarning(attrPath.AtListIndex(key),
+			"Missing Group Member",
+			fmt.Sprintf("%s %q referenced by the group does not exist on the device yet. "+
+				"Apply fails unless it is created by the same apply.", kind, refs[key].ValueString()))
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *addressGroupResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *addressGroupResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Address group request received")
+
+	var plan addressGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgServiceObjAddressGroup(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Address Group "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Address group request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *addressGroupResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Address group request received")
+
+	var state addressGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetDeviceOrganizationAddressGroup(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Address group "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Address Group "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*group)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Address group request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *addressGroupResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Address group request received")
+
+	var plan addressGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgServiceObjAddressGroup(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Address Group "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Address group request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *addressGroupResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Address group request received")
+
+	var state addressGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgServiceObjAddressGroup(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Address Group "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Address group request completed")
+}
+
+// addressGroupResourceModel maps the resource schema data.
+type addressGroupResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Addresses        []types.String `tfsdk:"addresses"`
+	AddressGroups    []types.String `tfsdk:"address_groups"`
+	AddressFiles     []types.String `tfsdk:"address_files"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+}
+
+func (m addressGroupResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the address group to the client request format.
+func (m addressGroupResourceModel) toClient() vclient.DevObjectAddressGroup {
+	return vclient.DevObjectAddressGroup{
+		Name:          m.Name.ValueString(),
+		Description:   m.Description.ValueString(),
+		Tags:          stringList(m.Tags),
+		Addresses:     stringList(m.Addresses),
+		AddressGroups: stringList(m.AddressGroups),
+		AddressFiles:  stringList(m.AddressFiles),
+	}
+}
+
+// fromClient copies the address group read from director.
+func (m *addressGroupResourceModel) fromClient(group vclient.DevObjectAddressGroup) {
+	m.Name = types.StringValue(group.Name)
+	m.Description = stringValueOrNull(group.Description)
+	m.Tags = stringListValue(group.Tags, m.Tags)
+	m.Addresses = stringListValue(group.Addresses, m.Addresses)
+	m.AddressGroups = stringListValue(group.AddressGroups, m.AddressGroups)
+	m.AddressFiles = stringListValue(group.AddressFiles, m.AddressFiles)
+}
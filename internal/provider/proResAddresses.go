@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"versa-networks.com/vclient"
@@ -58,28 +63,41 @@ func (r *addressResource) Schema(_ context.Context,
 			"device_name": schema.StringAttribute{
 				Description: "Device name to be configured.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"organization_name": schema.StringAttribute{
 				Description: "Organization name for the device to be configured.",
 				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Description: "Timestamp of the last Terraform update of the configuration.",
 				Computed:    true,
 			},
+			"exclusive": schema.BoolAttribute{
+				Description: "Manage the full address table of the device organization. " +
+					"Addresses not listed in the configuration are reported as drift and deleted on apply. " +
+					"When false, addresses not listed are left untouched. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"address": schema.ListNestedAttribute{
 				Description: "List of addresses to be configured.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
+					Attributes: addressObjectAttributes(map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							Description: "Name of the address object.",
 							Required:    true,
 						},
-						"fqdn": schema.StringAttribute{
-							Description: "FQDN for the address object.",
-							Optional:    true,
-						},
+					}),
+					Validators: []validator.Object{
+						atMostOneOf(addressTypeAttributes...),
 					},
 				},
 			},
@@ -124,21 +142,26 @@ func (r *addressResource) Create(ctx context.Context,
 		return
 	}
 
-	addrListData := vclient.DevOjectsAddressListData{}
+	addrListData := addressListData(plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.Address)
 	addrList := &addrListData.AddrList
-	addrList.Count = len(plan.Address)
-	addrList.DeviceName = plan.DeviceName.ValueString()
-	addrList.OrganizationName = plan.OrganizationName.ValueString()
-
-	for _, val := range plan.Address {
-		address := vclient.DevObjectAddress{
-			Name: val.Name.ValueString(),
-			FQDN: val.FQDN.ValueString(),
+
+	if plan.Exclusive.ValueBool() {
+		if err := r.deleteUnmanaged(ctx, addrList.DeviceName,
+			addrList.OrganizationName, plan.Address); err != nil {
+			addClientError(&resp.Diagnostics,
+				"Error Deleting Unmanaged Addresses for Device "+addrList.DeviceName+
+					" Organization "+addrList.OrganizationName, err)
+			return
 		}
-		addrList.Addresses = append(addrList.Addresses, address)
 	}
 
-	r.client.CreateDevOrgServiceObjAddresses(ctx, addrListData)
+	if err := r.client.CreateDevOrgServiceObjAddresses(ctx, addrListData); err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Addresses for Device "+addrList.DeviceName+
+				" Organization "+addrList.OrganizationName, err)
+		return
+	}
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	plan.ID = types.StringValue("1")
@@ -199,32 +222,27 @@ func (r *addressResource) Read(ctx context.Context,
 
 	//fmt.Printf("RES-READ device %v Org %v\n", deviceName, organizationName)
 	addrData, err := r.client.GetDeviceOrganizationAddresses(ctx, deviceName, organizationName)
-	if err != nil {
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Device "+deviceName+" Organization "+organizationName+
+			" not found, removing addresses from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
 		tflog.Error(ctx, "Failed to get addresses for device "+deviceName+" Organization "+organizationName)
+		addClientError(&resp.Diagnostics,
+			"Error Reading Addresses for Device "+deviceName+" Organization "+organizationName, err)
+		return
 	} else {
 		state.DeviceName = types.StringValue(deviceName)
 		state.OrganizationName = types.StringValue(organizationName)
-		for _, val := range addrData.Addresses {
-			configAddrData := addressDataModel{
-				Name: types.StringValue(val.Name),
-				FQDN: types.StringValue(val.FQDN),
-			}
-			//fmt.Printf("Adding address %v\n", configAddrData)
-			//state.Address = append(state.Address, addressItemModel(configAddrData))
-			added := false
-			for stKey, stVal := range state.Address {
-				if stVal.Name == configAddrData.Name {
-					added = true
-					state.Address[stKey] = addressItemModel(configAddrData)
-					break
-				}
-			}
-			if added == false {
-				state.Address = append(state.Address, addressItemModel(configAddrData))
-			}
+		// Imported state has no addresses yet, adopt the whole table
+		imported := state.Address == nil
+		if state.Exclusive.IsNull() {
+			state.Exclusive = types.BoolValue(false)
 		}
+		state.Address = addressItemsReconcile(state.Address, addrData.Addresses,
+			state.Exclusive.ValueBool() || imported)
 	}
-	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	state.ID = types.StringValue("1")
 
 	tflog.Debug(ctx, "RESOURCE Read for Device: "+deviceName+
@@ -253,27 +271,66 @@ func (r *addressResource) Update(ctx context.Context,
 		return
 	}
 
-	addrListData := vclient.DevOjectsAddressListData{}
-	addrList := &addrListData.AddrList
-	addrList.Count = len(plan.Address)
-	addrList.DeviceName = plan.DeviceName.ValueString()
-	addrList.OrganizationName = plan.OrganizationName.ValueString()
-
-	for _, val := range plan.Address {
-		address := vclient.DevObjectAddress{
-			Name: val.Name.ValueString(),
-			FQDN: val.FQDN.ValueString(),
+	var state addressesResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceName := plan.DeviceName.ValueString()
+	organizationName := plan.OrganizationName.ValueString()
+
+	// Refresh only reports unmanaged addresses once exclusive is set in
+	// state, so look for them on director too. Addresses known to state
+	// are left to the diff.
+	if plan.Exclusive.ValueBool() {
+		known := append(append([]addressItemModel{}, plan.Address...), state.Address...)
+		if err := r.deleteUnmanaged(ctx, deviceName, organizationName, known); err != nil {
+			addClientError(&resp.Diagnostics,
+				"Error Deleting Unmanaged Addresses for Device "+deviceName+
+					" Organization "+organizationName, err)
+			return
 		}
-		addrList.Addresses = append(addrList.Addresses, address)
 	}
 
-	r.client.UpdateDevOrgServiceObjAddresses(ctx, addrListData)
+	diff := addressItemsDiff(state.Address, plan.Address)
 
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	applied, err := r.client.ApplyDevOrgServiceObjAddresses(ctx, deviceName, organizationName, diff)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Addresses for Device "+deviceName+
+				" Organization "+organizationName, err)
+
+		// Record only the changes director accepted before failure
+		for _, val := range applied.Removed {
+			state.Address = addressItemsRemove(state.Address, val.Name)
+		}
+		for _, val := range append(applied.Changed, applied.Added...) {
+			for _, planVal := range plan.Address {
+				if planVal.Name.ValueString() == val.Name {
+					state.Address = addressItemsUpsert(state.Address, planVal)
+					break
+				}
+			}
+		}
+		if !applied.Empty() {
+			state.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+		}
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	if diff.Empty() {
+		plan.LastUpdated = state.LastUpdated
+	} else {
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	}
 	plan.ID = types.StringValue("1")
 
-	tflog.Debug(ctx, "RESOURCE Update for Device: "+addrList.DeviceName+
-		" Organization: "+addrList.OrganizationName)
+	tflog.Debug(ctx, "RESOURCE Update for Device: "+deviceName+
+		" Organization: "+organizationName)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -296,39 +353,342 @@ func (r *addressResource) Delete(ctx context.Context,
 		return
 	}
 
-	addrListData := vclient.DevOjectsAddressListData{}
+	// Addresses may all be gone already, removed out of band or never set
+	if len(state.Address) == 0 {
+		tflog.Debug(ctx, "DELETE Address request completed, no addresses in state")
+		return
+	}
+
+	addrListData := addressListData(state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Address)
 	addrList := &addrListData.AddrList
-	addrList.Count = len(state.Address)
-	addrList.DeviceName = state.DeviceName.ValueString()
-	addrList.OrganizationName = state.OrganizationName.ValueString()
-
-	for _, val := range state.Address {
-		address := vclient.DevObjectAddress{
-			Name: val.Name.ValueString(),
-			FQDN: val.FQDN.ValueString(),
+
+	deleted, err := r.client.DeleteDevOrgServiceObjAddresses(ctx, addrListData)
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Addresses for Device "+addrList.DeviceName+
+				" Organization "+addrList.OrganizationName, err)
+
+		// Keep addresses that are still present on director in state
+		for _, val := range deleted {
+			state.Address = addressItemsRemove(state.Address, val.Name)
 		}
-		addrList.Addresses = append(addrList.Addresses, address)
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
 	}
-	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-	state.ID = types.StringValue("1")
-
-	r.client.DeleteDevOrgServiceObjAddresses(ctx, addrListData)
 
 	tflog.Debug(ctx, "RESOURCE Delete for Device: "+addrList.DeviceName+
 		" Organization: "+addrList.OrganizationName)
 }
 
+// deleteUnmanaged removes addresses of the device organization which are
+// not listed in items, used by exclusive mode to own the address table.
+func (r *addressResource) deleteUnmanaged(ctx context.Context,
+	deviceName string, organizationName string, items []addressItemModel) error {
+
+	addrData, err := r.client.GetDeviceOrganizationAddresses(ctx, deviceName, organizationName)
+	if vclient.IsNotFound(err) {
+		// Organization has no addresses yet
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var unmanaged []addressItemModel
+	for _, val := range addrData.Addresses {
+		if !addressItemsContain(items, val.Name) {
+			tflog.Debug(ctx, "Deleting unmanaged address "+val.Name)
+			unmanaged = append(unmanaged, addressItemFromClient(val, addressItemModel{}))
+		}
+	}
+	if len(unmanaged) == 0 {
+		return nil
+	}
+
+	_, err = r.client.DeleteDevOrgServiceObjAddresses(ctx,
+		addressListData(deviceName, organizationName, unmanaged))
+	return err
+}
+
+// addressItemsDiff computes the changes turning prior address items into
+// planned ones. Items are matched by name.
+func addressItemsDiff(prior []addressItemModel,
+	planned []addressItemModel) vclient.DevObjectsAddressDiff {
+
+	var diff vclient.DevObjectsAddressDiff
+	for _, val := range prior {
+		if !addressItemsContain(planned, val.Name.ValueString()) {
+			diff.Removed = append(diff.Removed, val.toClient())
+		}
+	}
+	for _, val := range planned {
+		address := val.toClient()
+		found := false
+		for _, priorVal := range prior {
+			if priorVal.Name.ValueString() == address.Name {
+				found = true
+				if !reflect.DeepEqual(priorVal.toClient(), address) {
+					diff.Changed = append(diff.Changed, address)
+				}
+				break
+			}
+		}
+		if !found {
+			diff.Added = append(diff.Added, address)
+		}
+	}
+	return diff
+}
+
+// addressListData converts address items from plan or state to the
+// client request format.
+func addressListData(deviceName string, organizationName string,
+	items []addressItemModel) vclient.DevOjectsAddressListData {
+
+	addrListData := vclient.DevOjectsAddressListData{}
+	addrList := &addrListData.AddrList
+	addrList.Count = len(items)
+	addrList.DeviceName = deviceName
+	addrList.OrganizationName = organizationName
+
+	for _, val := range items {
+		addrList.Addresses = append(addrList.Addresses, val.toClient())
+	}
+	return addrListData
+}
+
+// addressObjectAttributes adds the attributes describing an address object
+// to attributes, shared by versadirector_addresses and versadirector_address.
+func addressObjectAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	for name, attribute := range map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Description: "Description of the address object.",
+			Optional:    true,
+		},
+		"tags": schema.ListAttribute{
+			Description: "Tags of the address object.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"fqdn": schema.StringAttribute{
+			Description: "FQDN for the address object.",
+			Optional:    true,
+		},
+		"ipv4_prefix": schema.StringAttribute{
+			Description: "IPv4 prefix for the address object, e.g. 10.1.0.0/16.",
+			Optional:    true,
+			Validators:  []validator.String{ipv4PrefixValidator()},
+		},
+		"ipv6_prefix": schema.StringAttribute{
+			Description: "IPv6 prefix for the address object, e.g. 2001:db8::/32.",
+			Optional:    true,
+			Validators:  []validator.String{ipv6PrefixValidator()},
+		},
+		"ipv4_range": schema.SingleNestedAttribute{
+			Description: "IPv4 address range for the address object.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"start": schema.StringAttribute{
+					Description: "First IPv4 address of the range.",
+					Required:    true,
+					Validators:  []validator.String{ipv4AddressValidator()},
+				},
+				"end": schema.StringAttribute{
+					Description: "Last IPv4 address of the range.",
+					Required:    true,
+					Validators:  []validator.String{ipv4AddressValidator()},
+				},
+			},
+		},
+		"ipv4_wildcard_mask": schema.SingleNestedAttribute{
+			Description: "IPv4 address with wildcard mask for the address object.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"address": schema.StringAttribute{
+					Description: "IPv4 address, e.g. 10.0.1.0.",
+					Required:    true,
+					Validators:  []validator.String{ipv4AddressValidator()},
+				},
+				"mask": schema.StringAttribute{
+					Description: "Wildcard mask, e.g. 0.0.255.255.",
+					Required:    true,
+					Validators:  []validator.String{ipv4AddressValidator()},
+				},
+			},
+		},
+		"dynamic_address": schema.StringAttribute{
+			Description: "Dynamic address for the address object.",
+			Optional:    true,
+		},
+	} {
+		attributes[name] = attribute
+	}
+	return attributes
+}
+
+// addressTypeAttributes lists the mutually exclusive attributes selecting
+// the type of an address object.
+var addressTypeAttributes = []string{"fqdn", "ipv4_prefix", "ipv6_prefix",
+	"ipv4_range", "ipv4_wildcard_mask", "dynamic_address"}
+
+// typeAttributes returns the address type attributes set on the item.
+func (m addressItemModel) typeAttributes() []string {
+	var set []string
+	for key, isNull := range []bool{m.FQDN.IsNull(), m.IPv4Prefix.IsNull(),
+		m.IPv6Prefix.IsNull(), m.IPv4Range == nil, m.IPv4WildcardMask == nil,
+		m.DynamicAddress.IsNull()} {
+		if !isNull {
+			set = append(set, addressTypeAttributes[key])
+		}
+	}
+	return set
+}
+
+// toClient converts an address item to the client request format.
+func (m addressItemModel) toClient() vclient.DevObjectAddress {
+	address := vclient.DevObjectAddress{
+		Name:           m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		Tags:           stringList(m.Tags),
+		FQDN:           m.FQDN.ValueString(),
+		IPv4Prefix:     m.IPv4Prefix.ValueString(),
+		IPv6Prefix:     m.IPv6Prefix.ValueString(),
+		DynamicAddress: m.DynamicAddress.ValueString(),
+	}
+	if m.IPv4Range != nil {
+		address.IPv4Range = &vclient.DevObjectAddressRange{
+			Start: m.IPv4Range.Start.ValueString(),
+			End:   m.IPv4Range.End.ValueString(),
+		}
+	}
+	if m.IPv4WildcardMask != nil {
+		address.IPv4WildcardMask = &vclient.DevObjectAddressWildcard{
+			Address: m.IPv4WildcardMask.Address.ValueString(),
+			Mask:    m.IPv4WildcardMask.Mask.ValueString(),
+		}
+	}
+	return address
+}
+
+// addressItemFromClient converts an address object read from director,
+// leaving attributes director did not return null. Previous is the prior
+// item of the same name, it keeps an empty tags list empty.
+func addressItemFromClient(address vclient.DevObjectAddress,
+	previous addressItemModel) addressItemModel {
+
+	item := addressItemModel{
+		Name:           types.StringValue(address.Name),
+		Description:    stringValueOrNull(address.Description),
+		Tags:           stringListValue(address.Tags, previous.Tags),
+		FQDN:           stringValueOrNull(address.FQDN),
+		IPv4Prefix:     stringValueOrNull(address.IPv4Prefix),
+		IPv6Prefix:     stringValueOrNull(address.IPv6Prefix),
+		DynamicAddress: stringValueOrNull(address.DynamicAddress),
+	}
+	if address.IPv4Range != nil {
+		item.IPv4Range = &addressRangeModel{
+			Start: types.StringValue(address.IPv4Range.Start),
+			End:   types.StringValue(address.IPv4Range.End),
+		}
+	}
+	if address.IPv4WildcardMask != nil {
+		item.IPv4WildcardMask = &addressWildcardModel{
+			Address: types.StringValue(address.IPv4WildcardMask.Address),
+			Mask:    types.StringValue(address.IPv4WildcardMask.Mask),
+		}
+	}
+	return item
+}
+
+// addressItemsReconcile rebuilds items from the addresses read from
+// director keeping the order of items. Items no longer present on director
+// are dropped. In exclusive mode addresses missing in items are appended so
+// they show up as drift, otherwise they are ignored.
+func addressItemsReconcile(items []addressItemModel,
+	addresses []vclient.DevObjectAddress, exclusive bool) []addressItemModel {
+
+	reconciled := []addressItemModel{}
+	for _, val := range items {
+		for _, address := range addresses {
+			if address.Name == val.Name.ValueString() {
+				reconciled = append(reconciled, addressItemFromClient(address, val))
+				break
+			}
+		}
+	}
+	if exclusive {
+		for _, address := range addresses {
+			if !addressItemsContain(items, address.Name) {
+				reconciled = append(reconciled, addressItemFromClient(address, addressItemModel{}))
+			}
+		}
+	}
+	return reconciled
+}
+
+// addressItemsContain reports whether an item with the given name exists.
+func addressItemsContain(items []addressItemModel, name string) bool {
+	for _, val := range items {
+		if val.Name.ValueString() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// addressItemsUpsert replaces the item with the same name or appends it.
+func addressItemsUpsert(items []addressItemModel, item addressItemModel) []addressItemModel {
+	for key, val := range items {
+		if val.Name.ValueString() == item.Name.ValueString() {
+			items[key] = item
+			return items
+		}
+	}
+	return append(items, item)
+}
+
+// addressItemsRemove drops the item with the given name.
+func addressItemsRemove(items []addressItemModel, name string) []addressItemModel {
+	for key, val := range items {
+		if val.Name.ValueString() == name {
+			return append(items[:key], items[key+1:]...)
+		}
+	}
+	return items
+}
+
 // orderResourceModel maps the resource schema data.
 type addressesResourceModel struct {
 	ID               types.String       `tfsdk:"id"`
 	DeviceName       types.String       `tfsdk:"device_name"`
 	OrganizationName types.String       `tfsdk:"organization_name"`
 	LastUpdated      types.String       `tfsdk:"last_updated"`
+	Exclusive        types.Bool         `tfsdk:"exclusive"`
 	Address          []addressItemModel `tfsdk:"address"`
 }
 
 // addressItem
 type addressItemModel struct {
-	Name types.String `tfsdk:"name"`
-	FQDN types.String `tfsdk:"fqdn"`
+	Name             types.String          `tfsdk:"name"`
+	Description      types.String          `tfsdk:"description"`
+	Tags             []types.String        `tfsdk:"tags"`
+	FQDN             types.String          `tfsdk:"fqdn"`
+	IPv4Prefix       types.String          `tfsdk:"ipv4_prefix"`
+	IPv6Prefix       types.String          `tfsdk:"ipv6_prefix"`
+	IPv4Range        *addressRangeModel    `tfsdk:"ipv4_range"`
+	IPv4WildcardMask *addressWildcardModel `tfsdk:"ipv4_wildcard_mask"`
+	DynamicAddress   types.String          `tfsdk:"dynamic_address"`
+}
+
+// addressRangeModel maps the ipv4_range attribute.
+type addressRangeModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// addressWildcardModel maps the ipv4_wildcard_mask attribute.
+type addressWildcardModel struct {
+	Address types.String `tfsdk:"address"`
+	Mask    types.String `tfsdk:"mask"`
 }
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"versa-networks.com/vclient"
+)
+
+func testAddressItem(name string, prefix string) addressItemModel {
+	return addressItemModel{Name: types.StringValue(name), IPv4Prefix: types.StringValue(prefix)}
+}
+
+func testAddressNames(addresses []vclient.DevObjectAddress) string {
+	var names []string
+	for _, val := range addresses {
+		names = append(names, val.Name)
+	}
+	return strings.Join(names, " ")
+}
+
+func TestAddressItemsDiff(t *testing.T) {
+	a := testAddressItem("a", "10.1.0.0/16")
+	b := testAddressItem("b", "10.2.0.0/16")
+	c := testAddressItem("c", "10.3.0.0/16")
+	tests := map[string]struct {
+		prior   []addressItemModel
+		planned []addressItemModel
+		added   string
+		changed string
+		removed string
+	}{
+		"unchanged": {prior: []addressItemModel{a, b}, planned: []addressItemModel{a, b}},
+		"add":       {prior: []addressItemModel{a}, planned: []addressItemModel{a, b, c}, added: "b c"},
+		"remove":    {prior: []addressItemModel{a, b, c}, planned: []addressItemModel{b}, removed: "a c"},
+		"change": {prior: []addressItemModel{a, b},
+			planned: []addressItemModel{a, testAddressItem("b", "10.9.0.0/16")}, changed: "b"},
+		"rename": {prior: []addressItemModel{a, b},
+			planned: []addressItemModel{a, testAddressItem("d", "10.2.0.0/16")}, added: "d", removed: "b"},
+		"reorder": {prior: []addressItemModel{a, b, c}, planned: []addressItemModel{c, a, b}},
+		"empty":   {prior: []addressItemModel{a}, planned: []addressItemModel{}, removed: "a"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff := addressItemsDiff(test.prior, test.planned)
+			if got := testAddressNames(diff.Added); got != test.added {
+				t.Errorf("added: got %q, want %q", got, test.added)
+			}
+			if got := testAddressNames(diff.Changed); got != test.changed {
+				t.Errorf("changed: got %q, want %q", got, test.changed)
+			}
+			if got := testAddressNames(diff.Removed); got != test.removed {
+				t.Errorf("removed: got %q, want %q", got, test.removed)
+			}
+		})
+	}
+}
+
+func TestAddressItemsReconcile(t *testing.T) {
+	a := testAddressItem("a", "10.1.0.0/16")
+	b := testAddressItem("b", "10.2.0.0/16")
+	c := testAddressItem("c", "10.3.0.0/16")
+	director := func(items ...addressItemModel) []vclient.DevObjectAddress {
+		var addresses []vclient.DevObjectAddress
+		for _, val := range items {
+			addresses = append(addresses, val.toClient())
+		}
+		return addresses
+	}
+	tests := map[string]struct {
+		items     []addressItemModel
+		addresses []vclient.DevObjectAddress
+		exclusive bool
+		want      []addressItemModel
+	}{
+		"unchanged": {items: []addressItemModel{a, b}, addresses: director(a, b),
+			want: []addressItemModel{a, b}},
+		"added": {items: []addressItemModel{a}, addresses: director(a, b),
+			want: []addressItemModel{a}},
+		"added exclusive": {items: []addressItemModel{a}, addresses: director(c, a, b), exclusive: true,
+			want: []addressItemModel{a, c, b}},
+		"removed": {items: []addressItemModel{a, b, c}, addresses: director(a, c),
+			want: []addressItemModel{a, c}},
+		"changed": {items: []addressItemModel{a, b}, addresses: director(a, testAddressItem("b", "10.9.0.0/16")),
+			want: []addressItemModel{a, testAddressItem("b", "10.9.0.0/16")}},
+		"renamed": {items: []addressItemModel{a, b}, addresses: director(a, testAddressItem("d", "10.2.0.0/16")),
+			want: []addressItemModel{a}},
+		"renamed exclusive": {items: []addressItemModel{a, b}, exclusive: true,
+			addresses: director(a, testAddressItem("d", "10.2.0.0/16")),
+			want:      []addressItemModel{a, testAddressItem("d", "10.2.0.0/16")}},
+		/* order of items is kept whatever order director returns */
+		"reordered": {items: []addressItemModel{c, a, b}, addresses: director(a, b, c),
+			want: []addressItemModel{c, a, b}},
+		"all removed": {items: []addressItemModel{a}, addresses: nil,
+			want: []addressItemModel{}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := addressItemsReconcile(test.items, test.addresses, test.exclusive)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %v items, want %v", len(got), len(test.want))
+			}
+			for idx := range got {
+				if got[idx].Name != test.want[idx].Name || got[idx].IPv4Prefix != test.want[idx].IPv4Prefix {
+					t.Errorf("item %v: got %v %v, want %v %v", idx, got[idx].Name, got[idx].IPv4Prefix,
+						test.want[idx].Name, test.want[idx].IPv4Prefix)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &antivirusProfileResource{}
+	_ resource.ResourceWithConfigure   = &antivirusProfileResource{}
+	_ resource.ResourceWithImportState = &antivirusProfileResource{}
+)
+
+// NewAntivirusProfileResource is a helper function to simplify the provider implementation.
+func NewAntivirusProfileResource() resource.Resource {
+	return &antivirusProfileResource{}
+}
+
+// antivirusProfileResource manages an antivirus profile of a device organization.
+type antivirusProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *antivirusProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *antivirusProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_antivirus_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *antivirusProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the antivirus profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the antivirus profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Action for infected files, one of " +
+					strings.Join(antivirusActions, ", ") + ".",
+				Required:   true,
+				Validators: []validator.String{oneOf(antivirusActions...)},
+			},
+			"direction": schema.StringAttribute{
+				Description: "Direction of scanned transfers, one of " +
+					strings.Join(transferDirections, ", ") + ". Defaults to both.",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("both"),
+				Validators: []validator.String{oneOf(transferDirections...)},
+			},
+			"protocols": schema.ListAttribute{
+				Description: "Protocols of scanned transfers, any of " +
+					strings.Join(transferProtocols, ", ") + ".",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators:  []validator.List{listOneOf(transferProtocols...)},
+			},
+			"file_types": schema.ListAttribute{
+				Description: "File types to scan, e.g. exe or pdf. All files when not set.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *antivirusProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *antivirusProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Antivirus Profile request received")
+
+	var plan antivirusProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgAntivirusProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Antivirus Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Antivirus Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *antivirusProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Antivirus Profile request received")
+
+	var state antivirusProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationAntivirusProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Antivirus profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Antivirus Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Antivirus Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *antivirusProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Antivirus Profile request received")
+
+	var plan antivirusProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgAntivirusProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Antivirus Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Antivirus Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *antivirusProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Antivirus Profile request received")
+
+	var state antivirusProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgAntivirusProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Antivirus Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Antivirus Profile request completed")
+}
+
+// antivirusActions are the actions of antivirus profiles.
+var antivirusActions = []string{"alert", "allow", "block", "reject"}
+
+// transferProtocols are the file transfer protocols inspected by
+// antivirus and file filtering profiles.
+var transferProtocols = []string{"http", "ftp", "smtp", "pop3", "imap", "cifs"}
+
+// transferDirections are the directions of inspected file transfers.
+var transferDirections = []string{"download", "upload", "both"}
+
+// antivirusProfileResourceModel maps the resource schema data.
+type antivirusProfileResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Action           types.String   `tfsdk:"action"`
+	Direction        types.String   `tfsdk:"direction"`
+	Protocols        []types.String `tfsdk:"protocols"`
+	FileTypes        []types.String `tfsdk:"file_types"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+}
+
+func (m antivirusProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the profile to the client request format.
+func (m antivirusProfileResourceModel) toClient() vclient.DevAntivirusProfile {
+	return vclient.DevAntivirusProfile{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+		Action:      m.Action.ValueString(),
+		Direction:   m.Direction.ValueString(),
+		Protocols:   stringList(m.Protocols),
+		FileTypes:   stringList(m.FileTypes),
+	}
+}
+
+// fromClient copies the profile read from director.
+func (m *antivirusProfileResourceModel) fromClient(profile vclient.DevAntivirusProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+	m.Action = types.StringValue(profile.Action)
+	m.Direction = types.StringValue(profile.Direction)
+	m.Protocols = stringListValue(profile.Protocols, m.Protocols)
+	m.FileTypes = stringListValue(profile.FileTypes, m.FileTypes)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &appQoSRuleResource{}
+	_ resource.ResourceWithConfigure      = &appQoSRuleResource{}
+	_ resource.ResourceWithImportState    = &appQoSRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &appQoSRuleResource{}
+	_ resource.ResourceWithValidateConfig = &appQoSRuleResource{}
+)
+
+// NewAppQoSRuleResource is a helper function to simplify the provider implementation.
+func NewAppQoSRuleResource() resource.Resource {
+	return &appQoSRuleResource{kind: appQoSRuleKind}
+}
+
+// appQoSRuleResource manages a rule of an app-QoS policy.
+type appQoSRuleResource = policyRuleResource[appQoSRuleResourceModel, vclient.DevAppQoSRuleSet]
+
+// appQoSRuleKind describes rules of app-QoS policies, applying a QoS profile to matched traffic.
+var appQoSRuleKind = policyRuleKind[vclient.DevAppQoSRuleSet]{
+	typeName:           "_app_qos_policy_rule",
+	title:              "App-QoS",
+	policy:             "app-QoS",
+	profile:            "qos_profile",
+	profileDescription: "Name of the QoS profile applied to matched traffic.",
+
+	set: func(profile string) vclient.DevAppQoSRuleSet {
+		return vclient.DevAppQoSRuleSet{QoSProfile: profile}
+	},
+	profileOf: func(set vclient.DevAppQoSRuleSet) string {
+		return set.QoSProfile
+	},
+
+	create: (*vclient.Client).CreateDevOrgAppQoSRule,
+	get:    (*vclient.Client).GetDeviceOrganizationAppQoSRule,
+	update: (*vclient.Client).UpdateDevOrgAppQoSRule,
+	delete: (*vclient.Client).DeleteDevOrgAppQoSRule,
+}
+
+// appQoSRuleResourceModel maps the resource schema data.
+type appQoSRuleResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	DeviceName               types.String   `tfsdk:"device_name"`
+	OrganizationName         types.String   `tfsdk:"organization_name"`
+	PolicyName               types.String   `tfsdk:"policy_name"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	Tags                     []types.String `tfsdk:"tags"`
+	Disabled                 types.Bool     `tfsdk:"disabled"`
+	Position                 types.String   `tfsdk:"position"`
+	RelativeTo               types.String   `tfsdk:"relative_to"`
+	SourceAddresses          []types.String `tfsdk:"source_addresses"`
+	SourceAddressGroups      []types.String `tfsdk:"source_address_groups"`
+	DestinationAddresses     []types.String `tfsdk:"destination_addresses"`
+	DestinationAddressGroups []types.String `tfsdk:"destination_address_groups"`
+	Applications             []types.String `tfsdk:"applications"`
+	CustomApplications       []types.String `tfsdk:"custom_applications"`
+	DSCP                     []types.Int64  `tfsdk:"dscp"`
+	Profile                  types.String   `tfsdk:"qos_profile"`
+	LastUpdated              types.String   `tfsdk:"last_updated"`
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &cosMappingResource{}
+	_ resource.ResourceWithConfigure      = &cosMappingResource{}
+	_ resource.ResourceWithImportState    = &cosMappingResource{}
+	_ resource.ResourceWithValidateConfig = &cosMappingResource{}
+)
+
+// NewCoSMappingResource is a helper function to simplify the provider implementation.
+func NewCoSMappingResource() resource.Resource {
+	return &cosMappingResource{}
+}
+
+// cosMappingResource manages a class of service classifier of a device
+// organization, mapping DSCP values to forwarding classes.
+type cosMappingResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *cosMappingResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *cosMappingResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_class_of_service_mapping"
+}
+
+// Schema defines the schema for the resource.
+func (r *cosMappingResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the class of service mapping in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the class of service mapping.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the classifier.",
+				Optional:    true,
+			},
+			"mappings": schema.ListNestedAttribute{
+				Description: "Forwarding class and loss priority of DSCP values.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dscp": schema.ListAttribute{
+							Description: "DSCP values from 0 to 63.",
+							ElementType: types.Int64Type,
+							Required:    true,
+							Validators:  []validator.List{listInt64Between(0, 63)},
+						},
+						"forwarding_class": schema.StringAttribute{
+							Description: "Forwarding class of the traffic, fc0 to fc15.",
+							Required:    true,
+							Validators:  []validator.String{oneOf(forwardingClasses()...)},
+						},
+						"loss_priority": schema.StringAttribute{
+							Description: "Loss priority of the traffic, low or high.",
+							Optional:    true,
+							Validators: []validator.String{oneOf(vclient.LossPriorityLow,
+								vclient.LossPriorityHigh)},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks no DSCP value is mapped twice.
+func (r *cosMappingResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config cosMappingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapped := map[int64]bool{}
+	for key, val := range config.Mappings {
+		for idx, dscp := range val.DSCP {
+			if dscp.IsNull() || dscp.IsUnknown() {
+				continue
+			}
+			if mapped[dscp.ValueInt64()] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("mappings").AtListIndex(key).AtName("dscp").AtListIndex(idx),
+					"Invalid Attribute Value",
+					fmt.Sprintf("DSCP value %d is already mapped.", dscp.ValueInt64()))
+			}
+			mapped[dscp.ValueInt64()] = true
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cosMappingResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *cosMappingResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Class of Service Mapping request received")
+
+	var plan cosMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgCoSClassifier(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Class of Service Mapping "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Class of Service Mapping request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *cosMappingResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Class of Service Mapping request received")
+
+	var state cosMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	classifier, err := r.client.GetDeviceOrganizationCoSClassifier(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Class of service mapping "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Class of Service Mapping "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*classifier)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Class of Service Mapping request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *cosMappingResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Class of Service Mapping request received")
+
+	var plan cosMappingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgCoSClassifier(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Class of Service Mapping "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Class of Service Mapping request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *cosMappingResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Class of Service Mapping request received")
+
+	var state cosMappingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgCoSClassifier(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Class of Service Mapping "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Class of Service Mapping request completed")
+}
+
+// cosMappingResourceModel maps the resource schema data.
+type cosMappingResourceModel struct {
+	ID               types.String      `tfsdk:"id"`
+	DeviceName       types.String      `tfsdk:"device_name"`
+	OrganizationName types.String      `tfsdk:"organization_name"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	Mappings         []cosMappingModel `tfsdk:"mappings"`
+	LastUpdated      types.String      `tfsdk:"last_updated"`
+}
+
+// cosMappingModel maps DSCP mapping schema data.
+type cosMappingModel struct {
+	DSCP            []types.Int64 `tfsdk:"dscp"`
+	ForwardingClass types.String  `tfsdk:"forwarding_class"`
+	LossPriority    types.String  `tfsdk:"loss_priority"`
+}
+
+func (m cosMappingResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the mapping to the client classifier format.
+func (m cosMappingResourceModel) toClient() vclient.DevCoSClassifier {
+	classifier := vclient.DevCoSClassifier{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+	for _, val := range m.Mappings {
+		mapping := vclient.DevCoSClassifierMapping{
+			ForwardingClass: val.ForwardingClass.ValueString(),
+			LossPriority:    val.LossPriority.ValueString(),
+		}
+		for _, dscp := range val.DSCP {
+			mapping.Values = append(mapping.Values, dscp.ValueInt64())
+		}
+		classifier.Mappings = append(classifier.Mappings, mapping)
+	}
+	return classifier
+}
+
+// fromClient copies the classifier read from director.
+func (m *cosMappingResourceModel) fromClient(classifier vclient.DevCoSClassifier) {
+	m.Name = types.StringValue(classifier.Name)
+	m.Description = stringValueOrNull(classifier.Description)
+
+	prior := m.Mappings
+	m.Mappings = nil
+	for key, val := range classifier.Mappings {
+		var previous cosMappingModel
+		if key < len(prior) {
+			previous = prior[key]
+		}
+		m.Mappings = append(m.Mappings, cosMappingModel{
+			ForwardingClass: types.StringValue(val.ForwardingClass),
+			LossPriority:    stringValueOrNull(val.LossPriority),
+			DSCP:            int64ListValue(val.Values, previous.DSCP),
+		})
+	}
+}
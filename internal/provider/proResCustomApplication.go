@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customApplicationResource{}
+	_ resource.ResourceWithConfigure      = &customApplicationResource{}
+	_ resource.ResourceWithImportState    = &customApplicationResource{}
+	_ resource.ResourceWithValidateConfig = &customApplicationResource{}
+)
+
+// NewCustomApplicationResource is a helper function to simplify the provider implementation.
+func NewCustomApplicationResource() resource.Resource {
+	return &customApplicationResource{}
+}
+
+// customApplicationResource manages a user defined application of a device organization.
+type customApplicationResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *customApplicationResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *customApplicationResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_custom_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *customApplicationResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the custom application in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the custom application.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the application.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the application.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"family": schema.StringAttribute{
+				Description: "Application family, e.g. business-system.",
+				Optional:    true,
+			},
+			"subfamily": schema.StringAttribute{
+				Description: "Application subfamily, e.g. database.",
+				Optional:    true,
+			},
+			"risk": schema.Int64Attribute{
+				Description: "Risk of the application from 1, lowest, to 5.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(1, 5)},
+			},
+			"productivity": schema.Int64Attribute{
+				Description: "Productivity of the application from 1, lowest, to 5.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(1, 5)},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Signatures identifying the application, traffic matching any rule is classified as the application.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Required:    true,
+						},
+						"host_pattern": schema.StringAttribute{
+							Description: "Regular expression matching the host name, e.g. .*\\.example\\.com.",
+							Optional:    true,
+						},
+						"url_pattern": schema.StringAttribute{
+							Description: "Regular expression matching the URL path.",
+							Optional:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "IP protocol, one of tcp or udp.",
+							Optional:    true,
+							Validators:  []validator.String{oneOf("tcp", "udp")},
+						},
+						"destination_port": schema.StringAttribute{
+							Description: "Destination port or port range, e.g. 8443 or 5000-5100.",
+							Optional:    true,
+							Validators:  []validator.String{portRange()},
+						},
+						"destination_prefix": schema.StringAttribute{
+							Description: "Destination IPv4 prefix, e.g. 203.0.113.0/24.",
+							Optional:    true,
+							Validators:  []validator.String{ipv4PrefixValidator()},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks every rule has a signature to match.
+func (r *customApplicationResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config customApplicationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, val := range config.Rules {
+		if val.HostPattern.IsNull() && val.URLPattern.IsNull() &&
+			val.DestinationPort.IsNull() && val.DestinationPrefix.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("rules").AtListIndex(key),
+				"Missing Attribute",
+				"One of host_pattern, url_pattern, destination_port or destination_prefix must be set.")
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customApplicationResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customApplicationResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Custom Application request received")
+
+	var plan customApplicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgCustomApplication(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Custom Application "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Custom Application request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customApplicationResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Custom Application request received")
+
+	var state customApplicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.client.GetDeviceOrganizationCustomApplication(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Custom application "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Custom Application "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*app)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Custom Application request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customApplicationResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Custom Application request received")
+
+	var plan customApplicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgCustomApplication(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Custom Application "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Custom Application request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customApplicationResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Custom Application request received")
+
+	var state customApplicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgCustomApplication(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Custom Application "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Custom Application request completed")
+}
+
+// customApplicationResourceModel maps the resource schema data.
+type customApplicationResourceModel struct {
+	ID               types.String                 `tfsdk:"id"`
+	DeviceName       types.String                 `tfsdk:"device_name"`
+	OrganizationName types.String                 `tfsdk:"organization_name"`
+	Name             types.String                 `tfsdk:"name"`
+	Description      types.String                 `tfsdk:"description"`
+	Tags             []types.String               `tfsdk:"tags"`
+	Family           types.String                 `tfsdk:"family"`
+	SubFamily        types.String                 `tfsdk:"subfamily"`
+	Risk             types.Int64                  `tfsdk:"risk"`
+	Productivity     types.Int64                  `tfsdk:"productivity"`
+	Rules            []customApplicationRuleModel `tfsdk:"rules"`
+	LastUpdated      types.String                 `tfsdk:"last_updated"`
+}
+
+// customApplicationRuleModel maps rule schema data.
+type customApplicationRuleModel struct {
+	Name              types.String `tfsdk:"name"`
+	HostPattern       types.String `tfsdk:"host_pattern"`
+	URLPattern        types.String `tfsdk:"url_pattern"`
+	Protocol          types.String `tfsdk:"protocol"`
+	DestinationPort   types.String `tfsdk:"destination_port"`
+	DestinationPrefix types.String `tfsdk:"destination_prefix"`
+}
+
+func (m customApplicationResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the application to the client request format.
+func (m customApplicationResourceModel) toClient() vclient.DevCustomApplication {
+	app := vclient.DevCustomApplication{
+		Name:         m.Name.ValueString(),
+		Description:  m.Description.ValueString(),
+		Tags:         stringList(m.Tags),
+		Family:       m.Family.ValueString(),
+		SubFamily:    m.SubFamily.ValueString(),
+		Risk:         int64Pointer(m.Risk),
+		Productivity: int64Pointer(m.Productivity),
+	}
+	for _, val := range m.Rules {
+		app.Rules = append(app.Rules, vclient.DevCustomApplicationRule{
+			Name:              val.Name.ValueString(),
+			HostPattern:       val.HostPattern.ValueString(),
+			URLPattern:        val.URLPattern.ValueString(),
+			Protocol:          val.Protocol.ValueString(),
+			DestinationPort:   val.DestinationPort.ValueString(),
+			DestinationPrefix: val.DestinationPrefix.ValueString(),
+		})
+	}
+	return app
+}
+
+// fromClient copies the application read from director.
+func (m *customApplicationResourceModel) fromClient(app vclient.DevCustomApplication) {
+	m.Name = types.StringValue(app.Name)
+	m.Description = stringValueOrNull(app.Description)
+	m.Tags = stringListValue(app.Tags, m.Tags)
+	m.Family = stringValueOrNull(app.Family)
+	m.SubFamily = stringValueOrNull(app.SubFamily)
+	m.Risk = int64ValueOrNull(app.Risk)
+	m.Productivity = int64ValueOrNull(app.Productivity)
+
+	m.Rules = nil
+	for _, val := range app.Rules {
+		m.Rules = append(m.Rules, customApplicationRuleModel{
+			Name:              types.StringValue(val.Name),
+			HostPattern:       stringValueOrNull(val.HostPattern),
+			URLPattern:        stringValueOrNull(val.URLPattern),
+			Protocol:          stringValueOrNull(val.Protocol),
+			DestinationPort:   stringValueOrNull(val.DestinationPort),
+			DestinationPrefix: stringValueOrNull(val.DestinationPrefix),
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customURLCategoryResource{}
+	_ resource.ResourceWithConfigure      = &customURLCategoryResource{}
+	_ resource.ResourceWithImportState    = &customURLCategoryResource{}
+	_ resource.ResourceWithValidateConfig = &customURLCategoryResource{}
+)
+
+// NewCustomURLCategoryResource is a helper function to simplify the provider implementation.
+func NewCustomURLCategoryResource() resource.Resource {
+	return &customURLCategoryResource{}
+}
+
+// customURLCategoryResource manages a user defined URL category of a device organization.
+type customURLCategoryResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *customURLCategoryResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *customURLCategoryResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_custom_url_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *customURLCategoryResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the custom URL category in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the custom URL category.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the category.",
+				Optional:    true,
+			},
+			"confidence": schema.Int64Attribute{
+				Description: "Confidence of the categorization from 0 to 100.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(0, 100)},
+			},
+			"urls": schema.ListAttribute{
+				Description: "URLs of the category, matched exactly.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"url_patterns": schema.ListAttribute{
+				Description: "Regular expressions matching URLs of the category.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the category matches at least one URL.
+func (r *customURLCategoryResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config customURLCategoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.URLs == nil && config.URLPatterns == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("urls"),
+			"Missing Attribute",
+			"One of urls or url_patterns must be set.")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customURLCategoryResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customURLCategoryResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Custom URL Category request received")
+
+	var plan customURLCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgCustomURLCategory(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Custom URL Category "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Custom URL Category request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customURLCategoryResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Custom URL Category request received")
+
+	var state customURLCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetDeviceOrganizationCustomURLCategory(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Custom URL category "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Custom URL Category "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*category)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Custom URL Category request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customURLCategoryResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Custom URL Category request received")
+
+	var plan customURLCategoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgCustomURLCategory(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Custom URL Category "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Custom URL Category request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customURLCategoryResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Custom URL Category request received")
+
+	var state customURLCategoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgCustomURLCategory(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Custom URL Category "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Custom URL Category request completed")
+}
+
+// customURLCategoryResourceModel maps the resource schema data.
+type customURLCategoryResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Confidence       types.Int64    `tfsdk:"confidence"`
+	URLs             []types.String `tfsdk:"urls"`
+	URLPatterns      []types.String `tfsdk:"url_patterns"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+}
+
+func (m customURLCategoryResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the category to the client request format.
+func (m customURLCategoryResourceModel) toClient() vclient.DevCustomURLCategory {
+	category := vclient.DevCustomURLCategory{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Confidence:  int64Pointer(m.Confidence),
+		URLs:        &vclient.DevCustomURLCategoryURLs{},
+	}
+	for _, val := range m.URLs {
+		category.URLs.Strings = append(category.URLs.Strings,
+			vclient.DevCustomURLCategoryString{Value: val.ValueString()})
+	}
+	for _, val := range m.URLPatterns {
+		category.URLs.Patterns = append(category.URLs.Patterns,
+			vclient.DevCustomURLCategoryPattern{Value: val.ValueString()})
+	}
+	return category
+}
+
+// fromClient copies the category read from director.
+func (m *customURLCategoryResourceModel) fromClient(category vclient.DevCustomURLCategory) {
+	m.Name = types.StringValue(category.Name)
+	m.Description = stringValueOrNull(category.Description)
+	m.Confidence = int64ValueOrNull(category.Confidence)
+
+	var urls, patterns []string
+	if category.URLs != nil {
+		for _, val := range category.URLs.Strings {
+			urls = append(urls, val.Value)
+		}
+		for _, val := range category.URLs.Patterns {
+			patterns = append(patterns, val.Value)
+		}
+	}
+	m.URLs = stringListValue(urls, m.URLs)
+	m.URLPatterns = stringListValue(patterns, m.URLPatterns)
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &fileFilteringProfileResource{}
+	_ resource.ResourceWithConfigure   = &fileFilteringProfileResource{}
+	_ resource.ResourceWithImportState = &fileFilteringProfileResource{}
+)
+
+// NewFileFilteringProfileResource is a helper function to simplify the provider implementation.
+func NewFileFilteringProfileResource() resource.Resource {
+	return &fileFilteringProfileResource{}
+}
+
+// fileFilteringProfileResource manages a file filtering profile of a device organization.
+type fileFilteringProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *fileFilteringProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *fileFilteringProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_file_filtering_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *fileFilteringProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the file filtering profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the file filtering profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_action": schema.StringAttribute{
+				Description: "Action for files matching no rule, one of " +
+					strings.Join(fileFilteringActions, ", ") + ". Defaults to allow.",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("allow"),
+				Validators: []validator.String{oneOf(fileFilteringActions...)},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules matching file transfers, evaluated in order.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Required:    true,
+						},
+						"file_types": schema.ListAttribute{
+							Description: "File types matched by the rule, e.g. exe or pdf.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"protocols": schema.ListAttribute{
+							Description: "Protocols matched by the rule, any of " +
+								strings.Join(transferProtocols, ", ") + ". All protocols when not set.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators:  []validator.List{listOneOf(transferProtocols...)},
+						},
+						"direction": schema.StringAttribute{
+							Description: "Direction matched by the rule, one of " +
+								strings.Join(transferDirections, ", ") + ". Both when not set.",
+							Optional:   true,
+							Validators: []validator.String{oneOf(transferDirections...)},
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for matched files, one of " +
+								strings.Join(fileFilteringActions, ", ") + ".",
+							Required:   true,
+							Validators: []validator.String{oneOf(fileFilteringActions...)},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *fileFilteringProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *fileFilteringProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE File Filtering Profile request received")
+
+	var plan fileFilteringProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgFileFilteringProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating File Filtering Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE File Filtering Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *fileFilteringProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ File Filtering Profile request received")
+
+	var state fileFilteringProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationFileFilteringProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "File filtering profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading File Filtering Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ File Filtering Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *fileFilteringProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE File Filtering Profile request received")
+
+	var plan fileFilteringProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgFileFilteringProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating File Filtering Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE File Filtering Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *fileFilteringProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE File Filtering Profile request received")
+
+	var state fileFilteringProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgFileFilteringProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting File Filtering Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE File Filtering Profile request completed")
+}
+
+// fileFilteringActions are the actions of file filtering profiles.
+var fileFilteringActions = []string{"allow", "alert", "block", "reject"}
+
+// fileFilteringProfileResourceModel maps the resource schema data.
+type fileFilteringProfileResourceModel struct {
+	ID               types.String             `tfsdk:"id"`
+	DeviceName       types.String             `tfsdk:"device_name"`
+	OrganizationName types.String             `tfsdk:"organization_name"`
+	Name             types.String             `tfsdk:"name"`
+	Description      types.String             `tfsdk:"description"`
+	Tags             []types.String           `tfsdk:"tags"`
+	DefaultAction    types.String             `tfsdk:"default_action"`
+	Rules            []fileFilteringRuleModel `tfsdk:"rules"`
+	LastUpdated      types.String             `tfsdk:"last_updated"`
+}
+
+// fileFilteringRuleModel maps rule schema data.
+type fileFilteringRuleModel struct {
+	Name      types.String   `tfsdk:"name"`
+	FileTypes []types.String `tfsdk:"file_types"`
+	Protocols []types.String `tfsdk:"protocols"`
+	Direction types.String   `tfsdk:"direction"`
+	Action    types.String   `tfsdk:"action"`
+}
+
+func (m fileFilteringProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the profile to the client request format.
+func (m fileFilteringProfileResourceModel) toClient() vclient.DevFileFilteringProfile {
+	profile := vclient.DevFileFilteringProfile{
+		Name:          m.Name.ValueString(),
+		Description:   m.Description.ValueString(),
+		Tags:          stringList(m.Tags),
+		DefaultAction: m.DefaultAction.ValueString(),
+	}
+	for _, val := range m.Rules {
+		profile.Rules = append(profile.Rules, vclient.DevFileFilteringRule{
+			Name:      val.Name.ValueString(),
+			FileTypes: stringList(val.FileTypes),
+			Protocols: stringList(val.Protocols),
+			Direction: val.Direction.ValueString(),
+			Action:    val.Action.ValueString(),
+		})
+	}
+	return profile
+}
+
+// fromClient copies the profile read from director.
+func (m *fileFilteringProfileResourceModel) fromClient(profile vclient.DevFileFilteringProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+	m.DefaultAction = stringValueOrNull(profile.DefaultAction)
+
+	prior := m.Rules
+	m.Rules = nil
+	for key, val := range profile.Rules {
+		var previous fileFilteringRuleModel
+		if key < len(prior) {
+			previous = prior[key]
+		}
+		m.Rules = append(m.Rules, fileFilteringRuleModel{
+			Name:      types.StringValue(val.Name),
+			FileTypes: stringListValue(val.FileTypes, previous.FileTypes),
+			Protocols: stringListValue(val.Protocols, previous.Protocols),
+			Direction: stringValueOrNull(val.Direction),
+			Action:    types.StringValue(val.Action),
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &forwardingProfileResource{}
+	_ resource.ResourceWithConfigure      = &forwardingProfileResource{}
+	_ resource.ResourceWithImportState    = &forwardingProfileResource{}
+	_ resource.ResourceWithValidateConfig = &forwardingProfileResource{}
+)
+
+// NewForwardingProfileResource is a helper function to simplify the provider implementation.
+func NewForwardingProfileResource() resource.Resource {
+	return &forwardingProfileResource{}
+}
+
+// forwardingProfileResource manages an SD-WAN forwarding profile of a device organization.
+type forwardingProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *forwardingProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *forwardingProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_forwarding_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *forwardingProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the forwarding profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the forwarding profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the forwarding profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the forwarding profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"circuit_priorities": schema.ListNestedAttribute{
+				Description: "WAN circuits by priority, circuits of the lowest priority value are used first.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"priority": schema.Int64Attribute{
+							Description: "Priority from 1, highest, to 16.",
+							Required:    true,
+							Validators:  []validator.Int64{int64Between(1, 16)},
+						},
+						"circuits": schema.ListAttribute{
+							Description: "Names of WAN circuits, e.g. MPLS or Internet.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"load_balancing": schema.StringAttribute{
+				Description: "Load balancing over circuits of the same priority, per-flow or per-packet. Defaults to per-flow.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vclient.LoadBalancePerFlow),
+				Validators: []validator.String{oneOf(vclient.LoadBalancePerFlow,
+					vclient.LoadBalancePerPacket)},
+			},
+			"sla_profile": schema.StringAttribute{
+				Description: "Name of the SLA profile circuits must meet.",
+				Optional:    true,
+			},
+			"replication": schema.BoolAttribute{
+				Description: "Send packets over several circuits at the same time. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"replication_factor": schema.Int64Attribute{
+				Description: "Number of circuits packets are replicated to, from 2 to 4.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(2, 4)},
+			},
+			"fec": schema.BoolAttribute{
+				Description: "Send forward error correction packets. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks priorities are unique and replication_factor is
+// only set with replication.
+func (r *forwardingProfileResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config forwardingProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities := map[int64]bool{}
+	for key, val := range config.CircuitPriorities {
+		if val.Priority.IsNull() || val.Priority.IsUnknown() {
+			continue
+		}
+		if priorities[val.Priority.ValueInt64()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("circuit_priorities").AtListIndex(key).AtName("priority"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Priority %d is already used.", val.Priority.ValueInt64()))
+		}
+		priorities[val.Priority.ValueInt64()] = true
+	}
+
+	if !config.ReplicationFactor.IsNull() && !config.Replication.IsUnknown() &&
+		!config.Replication.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("replication_factor"),
+			"Invalid Attribute Combination",
+			"Attribute replication_factor can only be set when replication is true.")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *forwardingProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *forwardingProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Forwarding Profile request received")
+
+	var plan forwardingProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgForwardingProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Forwarding Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Forwarding Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *forwardingProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Forwarding Profile request received")
+
+	var state forwardingProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationForwardingProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Forwarding profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Forwarding Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Forwarding Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *forwardingProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Forwarding Profile request received")
+
+	var plan forwardingProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgForwardingProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Forwarding Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Forwarding Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *forwardingProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Forwarding Profile request received")
+
+	var state forwardingProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgForwardingProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Forwarding Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Forwarding Profile request completed")
+}
+
+// forwardingProfileResourceModel maps the resource schema data.
+type forwardingProfileResourceModel struct {
+	ID                types.String           `tfsdk:"id"`
+	DeviceName        types.String           `tfsdk:"device_name"`
+	OrganizationName  types.String           `tfsdk:"organization_name"`
+	Name              types.String           `tfsdk:"name"`
+	Description       types.String           `tfsdk:"description"`
+	Tags              []types.String         `tfsdk:"tags"`
+	CircuitPriorities []circuitPriorityModel `tfsdk:"circuit_priorities"`
+	LoadBalancing     types.String           `tfsdk:"load_balancing"`
+	SLAProfile        types.String           `tfsdk:"sla_profile"`
+	Replication       types.Bool             `tfsdk:"replication"`
+	ReplicationFactor types.Int64            `tfsdk:"replication_factor"`
+	FEC               types.Bool             `tfsdk:"fec"`
+	LastUpdated       types.String           `tfsdk:"last_updated"`
+}
+
+// circuitPriorityModel maps circuit priority schema data.
+type circuitPriorityModel struct {
+	Priority types.Int64    `tfsdk:"priority"`
+	Circuits []types.String `tfsdk:"circuits"`
+}
+
+func (m forwardingProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the forwarding profile to the client request format.
+func (m forwardingProfileResourceModel) toClient() vclient.DevForwardingProfile {
+	profile := vclient.DevForwardingProfile{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+		LoadBalance: m.LoadBalancing.ValueString(),
+		SLAProfile:  m.SLAProfile.ValueString(),
+	}
+	for _, val := range m.CircuitPriorities {
+		profile.CircuitPriorities = append(profile.CircuitPriorities,
+			vclient.DevForwardingCircuitPriority{
+				Priority: val.Priority.ValueInt64(),
+				Circuits: stringList(val.Circuits),
+			})
+	}
+	if m.Replication.ValueBool() {
+		profile.Replication = &vclient.DevForwardingReplication{
+			Enable: true,
+			Factor: int64Pointer(m.ReplicationFactor),
+		}
+	}
+	if m.FEC.ValueBool() {
+		profile.FEC = &vclient.DevForwardingFEC{Enable: true}
+	}
+	return profile
+}
+
+// fromClient copies the forwarding profile read from director.
+func (m *forwardingProfileResourceModel) fromClient(profile vclient.DevForwardingProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+	m.LoadBalancing = types.StringValue(profile.LoadBalance)
+	m.SLAProfile = stringValueOrNull(profile.SLAProfile)
+
+	prior := m.CircuitPriorities
+	m.CircuitPriorities = nil
+	for key, val := range profile.CircuitPriorities {
+		var previous circuitPriorityModel
+		if key < len(prior) {
+			previous = prior[key]
+		}
+		m.CircuitPriorities = append(m.CircuitPriorities, circuitPriorityModel{
+			Priority: types.Int64Value(val.Priority),
+			Circuits: stringListValue(val.Circuits, previous.Circuits),
+		})
+	}
+
+	m.Replication = types.BoolValue(false)
+	m.ReplicationFactor = types.Int64Null()
+	if profile.Replication != nil {
+		m.Replication = types.BoolValue(profile.Replication.Enable)
+		m.ReplicationFactor = int64ValueOrNull(profile.Replication.Factor)
+	}
+	m.FEC = types.BoolValue(profile.FEC != nil && profile.FEC.Enable)
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ipsProfileResource{}
+	_ resource.ResourceWithConfigure   = &ipsProfileResource{}
+	_ resource.ResourceWithImportState = &ipsProfileResource{}
+)
+
+// NewIPSProfileResource is a helper function to simplify the provider implementation.
+func NewIPSProfileResource() resource.Resource {
+	return &ipsProfileResource{}
+}
+
+// ipsProfileResource manages an IPS profile of a device organization.
+type ipsProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *ipsProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *ipsProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_ips_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *ipsProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the IPS profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the IPS profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules selecting signatures by severity, evaluated in order.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the rule.",
+							Required:    true,
+						},
+						"severities": schema.ListAttribute{
+							Description: "Severities of matched signatures, any of " +
+								strings.Join(ipsSeverities, ", ") + ". All severities when not set.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators:  []validator.List{listOneOf(ipsSeverities...)},
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for traffic matching the signatures, one of " +
+								strings.Join(ipsActions, ", ") + ".",
+							Required:   true,
+							Validators: []validator.String{oneOf(ipsActions...)},
+						},
+					},
+				},
+			},
+			"signature_overrides": schema.ListNestedAttribute{
+				Description: "Actions of single signatures, overriding the rules.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"signature_id": schema.Int64Attribute{
+							Description: "Identifier of the signature.",
+							Required:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for traffic matching the signature, one of " +
+								strings.Join(ipsActions, ", ") + ".",
+							Required:   true,
+							Validators: []validator.String{oneOf(ipsActions...)},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ipsProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ipsProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE IPS Profile request received")
+
+	var plan ipsProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgIPSProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating IPS Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE IPS Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ipsProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ IPS Profile request received")
+
+	var state ipsProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationIPSProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "IPS profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading IPS Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ IPS Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ipsProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE IPS Profile request received")
+
+	var plan ipsProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgIPSProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating IPS Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE IPS Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ipsProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE IPS Profile request received")
+
+	var state ipsProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgIPSProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting IPS Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE IPS Profile request completed")
+}
+
+// ipsSeverities are the severities of IPS signatures.
+var ipsSeverities = []string{"critical", "major", "minor", "warning", "not-assigned"}
+
+// ipsActions are the actions of IPS rules and signature overrides.
+var ipsActions = []string{"allow", "alert", "drop-packet", "drop-session",
+	"reset-client", "reset-server", "reset-client-and-server"}
+
+// ipsProfileResourceModel maps the resource schema data.
+type ipsProfileResourceModel struct {
+	ID                 types.String                `tfsdk:"id"`
+	DeviceName         types.String                `tfsdk:"device_name"`
+	OrganizationName   types.String                `tfsdk:"organization_name"`
+	Name               types.String                `tfsdk:"name"`
+	Description        types.String                `tfsdk:"description"`
+	Tags               []types.String              `tfsdk:"tags"`
+	Rules              []ipsRuleModel              `tfsdk:"rules"`
+	SignatureOverrides []ipsSignatureOverrideModel `tfsdk:"signature_overrides"`
+	LastUpdated        types.String                `tfsdk:"last_updated"`
+}
+
+// ipsRuleModel maps rule schema data.
+type ipsRuleModel struct {
+	Name       types.String   `tfsdk:"name"`
+	Severities []types.String `tfsdk:"severities"`
+	Action     types.String   `tfsdk:"action"`
+}
+
+// ipsSignatureOverrideModel maps signature override schema data.
+type ipsSignatureOverrideModel struct {
+	SignatureID types.Int64  `tfsdk:"signature_id"`
+	Action      types.String `tfsdk:"action"`
+}
+
+func (m ipsProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the profile to the client request format.
+func (m ipsProfileResourceModel) toClient() vclient.DevIPSProfile {
+	profile := vclient.DevIPSProfile{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+	}
+	for _, val := range m.Rules {
+		profile.Rules = append(profile.Rules, vclient.DevIPSRule{
+			Name:       val.Name.ValueString(),
+			Severities: stringList(val.Severities),
+			Action:     val.Action.ValueString(),
+		})
+	}
+	for _, val := range m.SignatureOverrides {
+		profile.Overrides = append(profile.Overrides, vclient.DevIPSOverride{
+			SignatureID: val.SignatureID.ValueInt64(),
+			Action:      val.Action.ValueString(),
+		})
+	}
+	return profile
+}
+
+// fromClient copies the profile read from director.
+func (m *ipsProfileResourceModel) fromClient(profile vclient.DevIPSProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+
+	prior := m.Rules
+	m.Rules = nil
+	for key, val := range profile.Rules {
+		var previous ipsRuleModel
+		if key < len(prior) {
+			previous = prior[key]
+		}
+		m.Rules = append(m.Rules, ipsRuleModel{
+			Name:       types.StringValue(val.Name),
+			Severities: stringListValue(val.Severities, previous.Severities),
+			Action:     types.StringValue(val.Action),
+		})
+	}
+	m.SignatureOverrides = nil
+	for _, val := range profile.Overrides {
+		m.SignatureOverrides = append(m.SignatureOverrides, ipsSignatureOverrideModel{
+			SignatureID: types.Int64Value(val.SignatureID),
+			Action:      types.StringValue(val.Action),
+		})
+	}
+}
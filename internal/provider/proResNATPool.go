@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &natPoolResource{}
+	_ resource.ResourceWithConfigure      = &natPoolResource{}
+	_ resource.ResourceWithImportState    = &natPoolResource{}
+	_ resource.ResourceWithValidateConfig = &natPoolResource{}
+)
+
+// NewNATPoolResource is a helper function to simplify the provider implementation.
+func NewNATPoolResource() resource.Resource {
+	return &natPoolResource{}
+}
+
+// natPoolResource manages a NAT pool of a device organization.
+type natPoolResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *natPoolResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *natPoolResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_nat_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *natPoolResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the NAT pool in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the NAT pool.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the NAT pool.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the NAT pool.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"prefixes": schema.ListAttribute{
+				Description: "IPv4 prefixes translated addresses are allocated from.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listIPv4Prefixes()},
+			},
+			"ranges": schema.ListNestedAttribute{
+				Description: "IPv4 address ranges translated addresses are allocated from.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							Description: "First IPv4 address of the range.",
+							Required:    true,
+							Validators:  []validator.String{ipv4AddressValidator()},
+						},
+						"end": schema.StringAttribute{
+							Description: "Last IPv4 address of the range.",
+							Required:    true,
+							Validators:  []validator.String{ipv4AddressValidator()},
+						},
+					},
+				},
+			},
+			"port_range": schema.StringAttribute{
+				Description: "Range of ports used for NAPT translation, e.g. 1024-65535.",
+				Optional:    true,
+				Validators:  []validator.String{portRange()},
+			},
+			"routing_instance": schema.StringAttribute{
+				Description: "Routing instance translated traffic is sent to.",
+				Optional:    true,
+			},
+			"egress_networks": schema.ListAttribute{
+				Description: "Networks translated traffic egresses on.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the pool has addresses to allocate from.
+func (r *natPoolResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var prefixes, ranges types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prefixes"), &prefixes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ranges"), &ranges)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if prefixes.IsNull() && ranges.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("prefixes"),
+			"Missing Attribute",
+			"One of prefixes or ranges must be set.")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *natPoolResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *natPoolResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE NAT Pool request received")
+
+	var plan natPoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgNATPool(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating NAT Pool "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE NAT Pool request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *natPoolResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ NAT Pool request received")
+
+	var state natPoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.GetDeviceOrganizationNATPool(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "NAT pool "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading NAT Pool "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*pool)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ NAT Pool request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *natPoolResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE NAT Pool request received")
+
+	var plan natPoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgNATPool(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating NAT Pool "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE NAT Pool request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *natPoolResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE NAT Pool request received")
+
+	var state natPoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgNATPool(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting NAT Pool "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE NAT Pool request completed")
+}
+
+// natPoolResourceModel maps the resource schema data.
+type natPoolResourceModel struct {
+	ID               types.String        `tfsdk:"id"`
+	DeviceName       types.String        `tfsdk:"device_name"`
+	OrganizationName types.String        `tfsdk:"organization_name"`
+	Name             types.String        `tfsdk:"name"`
+	Description      types.String        `tfsdk:"description"`
+	Tags             []types.String      `tfsdk:"tags"`
+	Prefixes         []types.String      `tfsdk:"prefixes"`
+	Ranges           []addressRangeModel `tfsdk:"ranges"`
+	PortRange        types.String        `tfsdk:"port_range"`
+	RoutingInstance  types.String        `tfsdk:"routing_instance"`
+	EgressNetworks   []types.String      `tfsdk:"egress_networks"`
+	LastUpdated      types.String        `tfsdk:"last_updated"`
+}
+
+func (m natPoolResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the NAT pool to the client request format.
+func (m natPoolResourceModel) toClient() vclient.DevNATPool {
+	pool := vclient.DevNATPool{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Tags:            stringList(m.Tags),
+		Prefixes:        stringList(m.Prefixes),
+		PortRange:       m.PortRange.ValueString(),
+		RoutingInstance: m.RoutingInstance.ValueString(),
+		EgressNetworks:  stringList(m.EgressNetworks),
+	}
+	for _, val := range m.Ranges {
+		pool.Ranges = append(pool.Ranges, vclient.DevObjectAddressRange{
+			Start: val.Start.ValueString(),
+			End:   val.End.ValueString(),
+		})
+	}
+	return pool
+}
+
+// fromClient copies the NAT pool read from director.
+func (m *natPoolResourceModel) fromClient(pool vclient.DevNATPool) {
+	m.Name = types.StringValue(pool.Name)
+	m.Description = stringValueOrNull(pool.Description)
+	m.Tags = stringListValue(pool.Tags, m.Tags)
+	m.Prefixes = stringListValue(pool.Prefixes, m.Prefixes)
+	m.PortRange = stringValueOrNull(pool.PortRange)
+	m.RoutingInstance = stringValueOrNull(pool.RoutingInstance)
+	m.EgressNetworks = stringListValue(pool.EgressNetworks, m.EgressNetworks)
+
+	m.Ranges = nil
+	for _, val := range pool.Ranges {
+		m.Ranges = append(m.Ranges, addressRangeModel{
+			Start: types.StringValue(val.Start),
+			End:   types.StringValue(val.End),
+		})
+	}
+}
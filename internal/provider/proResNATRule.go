@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &natRuleResource{}
+	_ resource.ResourceWithConfigure      = &natRuleResource{}
+	_ resource.ResourceWithImportState    = &natRuleResource{}
+	_ resource.ResourceWithValidateConfig = &natRuleResource{}
+)
+
+// natTranslationTypes maps translation_type values to director translation types.
+var natTranslationTypes = map[string]string{
+	"napt-44": vclient.NATTranslationNAPT44,
+	"static":  vclient.NATTranslationStatic,
+	"dnat":    vclient.NATTranslationDNAT,
+}
+
+// NewNATRuleResource is a helper function to simplify the provider implementation.
+func NewNATRuleResource() resource.Resource {
+	return &natRuleResource{}
+}
+
+// natRuleResource manages a source or destination NAT rule of a device
+// organization.
+type natRuleResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *natRuleResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *natRuleResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_nat_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *natRuleResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: ruleAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the rule in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Keep the rule configured but don't evaluate it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"source_zones": matchListAttribute(
+				"Source zones matched by the rule."),
+			"source_prefixes": schema.ListAttribute{
+				Description: "Source IPv4 prefixes matched by the rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listIPv4Prefixes()},
+			},
+			"destination_prefixes": schema.ListAttribute{
+				Description: "Destination IPv4 prefixes matched by the rule, required for dnat.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.List{listIPv4Prefixes()},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "IP protocol matched by the rule, one of tcp, udp or icmp.",
+				Optional:    true,
+				Validators:  []validator.String{oneOf("tcp", "udp", "icmp")},
+			},
+			"destination_port": schema.StringAttribute{
+				Description: "Destination port or port range matched by the rule, requires protocol tcp or udp.",
+				Optional:    true,
+				Validators:  []validator.String{portRange()},
+			},
+			"translation_type": schema.StringAttribute{
+				Description: "Translation applied to matched traffic: napt-44 translates source " +
+					"addresses and ports, static translates source addresses one to one and " +
+					"dnat translates destination addresses.",
+				Required:   true,
+				Validators: []validator.String{oneOf("napt-44", "static", "dnat")},
+			},
+			"pool": schema.StringAttribute{
+				Description: "Name of the NAT pool translated addresses are allocated from, " +
+					"the destination pool for dnat and the source pool otherwise.",
+				Required: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks position attributes and the match criteria needed
+// by the translation type.
+func (r *natRuleResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	validateRulePosition(ctx, req, resp)
+
+	var translationType, protocol, destinationPort types.String
+	var destinationPrefixes types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("translation_type"), &translationType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destination_prefixes"), &destinationPrefixes)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destination_port"), &destinationPort)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if translationType.ValueString() == "dnat" && destinationPrefixes.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_prefixes"),
+			"Missing Attribute",
+			"Attribute destination_prefixes must be set when translation_type is dnat.")
+	}
+
+	if !destinationPort.IsNull() && !protocol.IsUnknown() &&
+		protocol.ValueString() != "tcp" && protocol.ValueString() != "udp" {
+		resp.Diagnostics.AddAttributeError(path.Root("destination_port"),
+			"Invalid Attribute Combination",
+			"Attribute destination_port can only be set when protocol is tcp or udp.")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *natRuleResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *natRuleResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE NAT Rule request received")
+
+	var plan natRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgNATRule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient(),
+		rulePosition(plan.Position, plan.RelativeTo))
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating NAT Rule "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE NAT Rule request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *natRuleResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ NAT Rule request received")
+
+	var state natRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetDeviceOrganizationNATRule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "NAT rule "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading NAT Rule "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*rule)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ NAT Rule request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *natRuleResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE NAT Rule request received")
+
+	var plan natRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgNATRule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating NAT Rule "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE NAT Rule request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *natRuleResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE NAT Rule request received")
+
+	var state natRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgNATRule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting NAT Rule "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE NAT Rule request completed")
+}
+
+// natRuleResourceModel maps the resource schema data.
+type natRuleResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	DeviceName          types.String   `tfsdk:"device_name"`
+	OrganizationName    types.String   `tfsdk:"organization_name"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	Tags                []types.String `tfsdk:"tags"`
+	Disabled            types.Bool     `tfsdk:"disabled"`
+	Position            types.String   `tfsdk:"position"`
+	RelativeTo          types.String   `tfsdk:"relative_to"`
+	SourceZones         []types.String `tfsdk:"source_zones"`
+	SourcePrefixes      []types.String `tfsdk:"source_prefixes"`
+	DestinationPrefixes []types.String `tfsdk:"destination_prefixes"`
+	Protocol            types.String   `tfsdk:"protocol"`
+	DestinationPort     types.String   `tfsdk:"destination_port"`
+	TranslationType     types.String   `tfsdk:"translation_type"`
+	Pool                types.String   `tfsdk:"pool"`
+	LastUpdated         types.String   `tfsdk:"last_updated"`
+}
+
+func (m natRuleResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the rule to the client request format.
+func (m natRuleResourceModel) toClient() vclient.DevNATRule {
+	rule := vclient.DevNATRule{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+		Disabled:    m.Disabled.ValueBool(),
+		Match: vclient.DevNATRuleMatch{
+			SourceZones:         stringList(m.SourceZones),
+			SourcePrefixes:      stringList(m.SourcePrefixes),
+			DestinationPrefixes: stringList(m.DestinationPrefixes),
+			Protocol:            m.Protocol.ValueString(),
+			DestinationPort:     m.DestinationPort.ValueString(),
+		},
+		Set: vclient.DevNATRuleSet{
+			TranslationType: natTranslationTypes[m.TranslationType.ValueString()],
+		},
+	}
+	if rule.Set.TranslationType == vclient.NATTranslationDNAT {
+		rule.Set.DestinationPool = m.Pool.ValueString()
+	} else {
+		rule.Set.SourcePool = m.Pool.ValueString()
+	}
+	return rule
+}
+
+// fromClient copies the rule read from director. Position attributes
+// only apply on create and are kept as they are.
+func (m *natRuleResourceModel) fromClient(rule vclient.DevNATRule) {
+	m.Name = types.StringValue(rule.Name)
+	m.Description = stringValueOrNull(rule.Description)
+	m.Tags = stringListValue(rule.Tags, m.Tags)
+	m.Disabled = types.BoolValue(rule.Disabled)
+	m.SourceZones = stringListValue(rule.Match.SourceZones, m.SourceZones)
+	m.SourcePrefixes = stringListValue(rule.Match.SourcePrefixes, m.SourcePrefixes)
+	m.DestinationPrefixes = stringListValue(rule.Match.DestinationPrefixes, m.DestinationPrefixes)
+	m.Protocol = stringValueOrNull(rule.Match.Protocol)
+	m.DestinationPort = stringValueOrNull(rule.Match.DestinationPort)
+
+	// Translation types not managed by the provider are kept as received
+	// so that they show up as a change.
+	m.TranslationType = types.StringValue(rule.Set.TranslationType)
+	for key, val := range natTranslationTypes {
+		if val == rule.Set.TranslationType {
+			m.TranslationType = types.StringValue(key)
+		}
+	}
+	m.Pool = types.StringValue(rule.Set.SourcePool)
+	if rule.Set.TranslationType == vclient.NATTranslationDNAT {
+		m.Pool = types.StringValue(rule.Set.DestinationPool)
+	}
+}
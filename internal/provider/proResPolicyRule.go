@@ -0,0 +1,399 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// policyRuleModel lists the models of policy rule resources. They only
+// differ in the name of the profile attribute, so they convert to and
+// from policyRuleResourceModel.
+type policyRuleModel interface {
+	sdwanRuleResourceModel | appQoSRuleResourceModel
+}
+
+// policyRuleKind describes the rules of a policy, S is the client format
+// of the profile set on matched traffic.
+type policyRuleKind[S any] struct {
+	typeName           string // resource type name suffix
+	title              string // rule kind in log and error messages
+	policy             string // policy kind in attribute descriptions
+	profile            string // name of the profile attribute
+	profileDescription string
+
+	set       func(profile string) S
+	profileOf func(set S) string
+
+	create func(*vclient.Client, context.Context, string, string, string,
+		vclient.DevPolicyRule[S], vclient.ObjectPosition) error
+	get func(*vclient.Client, context.Context, string, string, string,
+		string) (*vclient.DevPolicyRule[S], error)
+	update func(*vclient.Client, context.Context, string, string, string,
+		vclient.DevPolicyRule[S]) error
+	delete func(*vclient.Client, context.Context, string, string, string, string) error
+}
+
+// policyRuleResource manages a rule of an SD-WAN or app-QoS policy. The
+// rules share match criteria and only differ in the profile applied to
+// matched traffic.
+type policyRuleResource[M policyRuleModel, S any] struct {
+	client *vclient.Client
+	kind   policyRuleKind[S]
+}
+
+// ImportState accepts the resource ID in device/organization/policy/name form.
+func (r *policyRuleResource[M, S]) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importCompositeID(ctx, req, resp, "device_name", "organization_name", "policy_name", "name")
+}
+
+// Metadata returns the resource type name.
+func (r *policyRuleResource[M, S]) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + r.kind.typeName
+}
+
+// Schema defines the schema for the resource.
+func (r *policyRuleResource[M, S]) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: ruleAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the rule in device/organization/policy/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Description: "Name of the " + r.kind.policy + " policy holding the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Keep the rule configured but don't evaluate it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"source_addresses": matchListAttribute(
+				"Source address objects matched by the rule."),
+			"source_address_groups": matchListAttribute(
+				"Source address groups matched by the rule."),
+			"destination_addresses": matchListAttribute(
+				"Destination address objects matched by the rule."),
+			"destination_address_groups": matchListAttribute(
+				"Destination address groups matched by the rule."),
+			"applications": matchListAttribute(
+				"Predefined application IDs matched by the rule, checked at plan time."),
+			"custom_applications": matchListAttribute(
+				"Custom applications matched by the rule."),
+			"dscp": schema.ListAttribute{
+				Description: "DSCP values from 0 to 63 matched by the rule.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators:  []validator.List{listInt64Between(0, 63)},
+			},
+			r.kind.profile: schema.StringAttribute{
+				Description: r.kind.profileDescription,
+				Required:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// ValidateConfig checks position attributes.
+func (r *policyRuleResource[M, S]) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	validateRulePosition(ctx, req, resp)
+}
+
+// ModifyPlan checks predefined applications matched by the rule are known
+// to director, so misspelled application IDs are reported at plan time.
+// Custom applications may be created by the same apply and aren't checked.
+func (r *policyRuleResource[M, S]) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+
+	checkPredefinedApplications(ctx, r.client, req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *policyRuleResource[M, S]) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *policyRuleResource[M, S]) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE "+r.kind.title+" Policy Rule request received")
+
+	var config M
+	diags := req.Plan.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan := policyRuleResourceModel(config)
+
+	err := r.kind.create(r.client, ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.PolicyName.ValueString(),
+		r.toClient(plan), rulePosition(plan.Position, plan.RelativeTo))
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating "+r.kind.title+" Policy Rule "+plan.Name.ValueString()+" in Policy "+
+				plan.PolicyName.ValueString()+" for Device "+plan.DeviceName.ValueString()+
+				" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, M(plan))
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE "+r.kind.title+" Policy Rule request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *policyRuleResource[M, S]) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ "+r.kind.title+" Policy Rule request received")
+
+	var current M
+	diags := req.State.Get(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := policyRuleResourceModel(current)
+
+	rule, err := r.kind.get(r.client, ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.PolicyName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, r.kind.title+" policy rule "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading "+r.kind.title+" Policy Rule "+state.Name.ValueString()+" in Policy "+
+				state.PolicyName.ValueString()+" for Device "+state.DeviceName.ValueString()+
+				" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	r.fromClient(&state, *rule)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, M(state))
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ "+r.kind.title+" Policy Rule request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyRuleResource[M, S]) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE "+r.kind.title+" Policy Rule request received")
+
+	var config M
+	diags := req.Plan.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan := policyRuleResourceModel(config)
+
+	err := r.kind.update(r.client, ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.PolicyName.ValueString(), r.toClient(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating "+r.kind.title+" Policy Rule "+plan.Name.ValueString()+" in Policy "+
+				plan.PolicyName.ValueString()+" for Device "+plan.DeviceName.ValueString()+
+				" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, M(plan))
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE "+r.kind.title+" Policy Rule request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *policyRuleResource[M, S]) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE "+r.kind.title+" Policy Rule request received")
+
+	var current M
+	diags := req.State.Get(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := policyRuleResourceModel(current)
+
+	err := r.kind.delete(r.client, ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.PolicyName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting "+r.kind.title+" Policy Rule "+state.Name.ValueString()+" in Policy "+
+				state.PolicyName.ValueString()+" for Device "+state.DeviceName.ValueString()+
+				" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE "+r.kind.title+" Policy Rule request completed")
+}
+
+// policyRuleResourceModel holds the rule attributes, converted from and to
+// the resource models which map them to the resource schema.
+type policyRuleResourceModel struct {
+	ID                       types.String
+	DeviceName               types.String
+	OrganizationName         types.String
+	PolicyName               types.String
+	Name                     types.String
+	Description              types.String
+	Tags                     []types.String
+	Disabled                 types.Bool
+	Position                 types.String
+	RelativeTo               types.String
+	SourceAddresses          []types.String
+	SourceAddressGroups      []types.String
+	DestinationAddresses     []types.String
+	DestinationAddressGroups []types.String
+	Applications             []types.String
+	CustomApplications       []types.String
+	DSCP                     []types.Int64
+	Profile                  types.String
+	LastUpdated              types.String
+}
+
+func (m policyRuleResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.PolicyName.ValueString()+"/"+m.Name.ValueString())
+}
+
+// toClient converts the rule to the client request format.
+func (r *policyRuleResource[M, S]) toClient(m policyRuleResourceModel) vclient.DevPolicyRule[S] {
+	rule := vclient.DevPolicyRule[S]{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+		Disabled:    m.Disabled.ValueBool(),
+		Set:         r.kind.set(m.Profile.ValueString()),
+	}
+
+	match := &rule.Match
+	if m.SourceAddresses != nil || m.SourceAddressGroups != nil {
+		match.Source = &vclient.DevPolicyRuleEndpoint{
+			Addresses:     stringList(m.SourceAddresses),
+			AddressGroups: stringList(m.SourceAddressGroups),
+		}
+	}
+	if m.DestinationAddresses != nil || m.DestinationAddressGroups != nil {
+		match.Destination = &vclient.DevPolicyRuleEndpoint{
+			Addresses:     stringList(m.DestinationAddresses),
+			AddressGroups: stringList(m.DestinationAddressGroups),
+		}
+	}
+	if m.Applications != nil || m.CustomApplications != nil {
+		match.Application = &vclient.DevSecurityRuleNames{
+			Predefined:  stringList(m.Applications),
+			UserDefined: stringList(m.CustomApplications),
+		}
+	}
+	for _, val := range m.DSCP {
+		match.DSCP = append(match.DSCP, val.ValueInt64())
+	}
+	return rule
+}
+
+// fromClient copies the rule read from director. Position attributes
+// only apply on create and are kept as they are.
+func (r *policyRuleResource[M, S]) fromClient(m *policyRuleResourceModel, rule vclient.DevPolicyRule[S]) {
+	m.Name = types.StringValue(rule.Name)
+	m.Description = stringValueOrNull(rule.Description)
+	m.Tags = stringListValue(rule.Tags, m.Tags)
+	m.Disabled = types.BoolValue(rule.Disabled)
+	m.Profile = types.StringValue(r.kind.profileOf(rule.Set))
+
+	source := vclient.DevPolicyRuleEndpoint{}
+	if rule.Match.Source != nil {
+		source = *rule.Match.Source
+	}
+	m.SourceAddresses = stringListValue(source.Addresses, m.SourceAddresses)
+	m.SourceAddressGroups = stringListValue(source.AddressGroups, m.SourceAddressGroups)
+
+	destination := vclient.DevPolicyRuleEndpoint{}
+	if rule.Match.Destination != nil {
+		destination = *rule.Match.Destination
+	}
+	m.DestinationAddresses = stringListValue(destination.Addresses, m.DestinationAddresses)
+	m.DestinationAddressGroups = stringListValue(destination.AddressGroups, m.DestinationAddressGroups)
+
+	applications := vclient.DevSecurityRuleNames{}
+	if rule.Match.Application != nil {
+		applications = *rule.Match.Application
+	}
+	m.Applications = stringListValue(applications.Predefined, m.Applications)
+	m.CustomApplications = stringListValue(applications.UserDefined, m.CustomApplications)
+
+	m.DSCP = int64ListValue(rule.Match.DSCP, m.DSCP)
+}
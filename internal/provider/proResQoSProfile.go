@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &qosProfileResource{}
+	_ resource.ResourceWithConfigure      = &qosProfileResource{}
+	_ resource.ResourceWithImportState    = &qosProfileResource{}
+	_ resource.ResourceWithValidateConfig = &qosProfileResource{}
+)
+
+// NewQoSProfileResource is a helper function to simplify the provider implementation.
+func NewQoSProfileResource() resource.Resource {
+	return &qosProfileResource{}
+}
+
+// qosProfileResource manages a QoS profile of a device organization.
+type qosProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *qosProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *qosProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_qos_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *qosProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the QoS profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the QoS profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the QoS profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the QoS profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"peak_rate": schema.StringAttribute{
+				Description: "Rate traffic is shaped to, e.g. 500kbps, 20Mbps or 1Gbps.",
+				Optional:    true,
+				Validators:  []validator.String{bandwidth()},
+			},
+			"burst_size": schema.Int64Attribute{
+				Description: "Burst size in bytes allowed above peak_rate.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(1, 1<<32-1)},
+			},
+			"forwarding_class": schema.StringAttribute{
+				Description: "Forwarding class assigned to the traffic, fc0 to fc15.",
+				Optional:    true,
+				Validators:  []validator.String{oneOf(forwardingClasses()...)},
+			},
+			"loss_priority": schema.StringAttribute{
+				Description: "Loss priority assigned to the traffic, low or high.",
+				Optional:    true,
+				Validators: []validator.String{oneOf(vclient.LossPriorityLow,
+					vclient.LossPriorityHigh)},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks burst_size is only set with peak_rate.
+func (r *qosProfileResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config qosProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.BurstSize.IsNull() && config.PeakRate.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("burst_size"),
+			"Invalid Attribute Combination",
+			"Attribute burst_size can only be set along with peak_rate.")
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *qosProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *qosProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE QoS Profile request received")
+
+	var plan qosProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgQoSProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating QoS Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE QoS Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *qosProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ QoS Profile request received")
+
+	var state qosProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationQoSProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "QoS profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading QoS Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ QoS Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *qosProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE QoS Profile request received")
+
+	var plan qosProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgQoSProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating QoS Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE QoS Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *qosProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE QoS Profile request received")
+
+	var state qosProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgQoSProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting QoS Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE QoS Profile request completed")
+}
+
+// forwardingClasses returns the forwarding class names fc0 to fc15.
+func forwardingClasses() []string {
+	classes := make([]string, 0, 16)
+	for key := 0; key < 16; key++ {
+		classes = append(classes, "fc"+strconv.Itoa(key))
+	}
+	return classes
+}
+
+// qosProfileResourceModel maps the resource schema data.
+type qosProfileResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	PeakRate         types.String   `tfsdk:"peak_rate"`
+	BurstSize        types.Int64    `tfsdk:"burst_size"`
+	ForwardingClass  types.String   `tfsdk:"forwarding_class"`
+	LossPriority     types.String   `tfsdk:"loss_priority"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+}
+
+func (m qosProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the QoS profile to the client request format. Peak
+// rate was validated at plan time.
+func (m qosProfileResourceModel) toClient() vclient.DevQoSProfile {
+	profile := vclient.DevQoSProfile{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Tags:            stringList(m.Tags),
+		BurstSize:       int64Pointer(m.BurstSize),
+		ForwardingClass: m.ForwardingClass.ValueString(),
+		LossPriority:    m.LossPriority.ValueString(),
+	}
+	if rate, err := parseBandwidth(m.PeakRate.ValueString()); err == nil {
+		profile.PeakRate = &rate
+	}
+	return profile
+}
+
+// fromClient copies the QoS profile read from director.
+func (m *qosProfileResourceModel) fromClient(profile vclient.DevQoSProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+	m.PeakRate = bandwidthValue(profile.PeakRate, m.PeakRate)
+	m.BurstSize = int64ValueOrNull(profile.BurstSize)
+	m.ForwardingClass = stringValueOrNull(profile.ForwardingClass)
+	m.LossPriority = stringValueOrNull(profile.LossPriority)
+}
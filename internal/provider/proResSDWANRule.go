@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &sdwanRuleResource{}
+	_ resource.ResourceWithConfigure      = &sdwanRuleResource{}
+	_ resource.ResourceWithImportState    = &sdwanRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &sdwanRuleResource{}
+	_ resource.ResourceWithValidateConfig = &sdwanRuleResource{}
+)
+
+// NewSDWANRuleResource is a helper function to simplify the provider implementation.
+func NewSDWANRuleResource() resource.Resource {
+	return &sdwanRuleResource{kind: sdwanRuleKind}
+}
+
+// sdwanRuleResource manages a traffic steering rule of an SD-WAN policy.
+type sdwanRuleResource = policyRuleResource[sdwanRuleResourceModel, vclient.DevSDWANRuleSet]
+
+// sdwanRuleKind describes rules of SD-WAN policies, steering matched traffic with a forwarding profile.
+var sdwanRuleKind = policyRuleKind[vclient.DevSDWANRuleSet]{
+	typeName:           "_sdwan_policy_rule",
+	title:              "SD-WAN",
+	policy:             "SD-WAN",
+	profile:            "forwarding_profile",
+	profileDescription: "Name of the forwarding profile steering matched traffic.",
+
+	set: func(profile string) vclient.DevSDWANRuleSet {
+		return vclient.DevSDWANRuleSet{ForwardingProfile: profile}
+	},
+	profileOf: func(set vclient.DevSDWANRuleSet) string {
+		return set.ForwardingProfile
+	},
+
+	create: (*vclient.Client).CreateDevOrgSDWANRule,
+	get:    (*vclient.Client).GetDeviceOrganizationSDWANRule,
+	update: (*vclient.Client).UpdateDevOrgSDWANRule,
+	delete: (*vclient.Client).DeleteDevOrgSDWANRule,
+}
+
+// sdwanRuleResourceModel maps the resource schema data.
+type sdwanRuleResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	DeviceName               types.String   `tfsdk:"device_name"`
+	OrganizationName         types.String   `tfsdk:"organization_name"`
+	PolicyName               types.String   `tfsdk:"policy_name"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	Tags                     []types.String `tfsdk:"tags"`
+	Disabled                 types.Bool     `tfsdk:"disabled"`
+	Position                 types.String   `tfsdk:"position"`
+	RelativeTo               types.String   `tfsdk:"relative_to"`
+	SourceAddresses          []types.String `tfsdk:"source_addresses"`
+	SourceAddressGroups      []types.String `tfsdk:"source_address_groups"`
+	DestinationAddresses     []types.String `tfsdk:"destination_addresses"`
+	DestinationAddressGroups []types.String `tfsdk:"destination_address_groups"`
+	Applications             []types.String `tfsdk:"applications"`
+	CustomApplications       []types.String `tfsdk:"custom_applications"`
+	DSCP                     []types.Int64  `tfsdk:"dscp"`
+	Profile                  types.String   `tfsdk:"forwarding_profile"`
+	LastUpdated              types.String   `tfsdk:"last_updated"`
+}
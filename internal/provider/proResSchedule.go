@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &scheduleResource{}
+	_ resource.ResourceWithConfigure      = &scheduleResource{}
+	_ resource.ResourceWithImportState    = &scheduleResource{}
+	_ resource.ResourceWithValidateConfig = &scheduleResource{}
+)
+
+// scheduleDays lists the days of weekly schedules in order.
+var scheduleDays = []string{"monday", "tuesday", "wednesday", "thursday",
+	"friday", "saturday", "sunday"}
+
+// NewScheduleResource is a helper function to simplify the provider implementation.
+func NewScheduleResource() resource.Resource {
+	return &scheduleResource{}
+}
+
+// scheduleResource manages a schedule of a device organization.
+type scheduleResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *scheduleResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *scheduleResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *scheduleResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the schedule in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the schedule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the schedule.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the schedule.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"daily": schema.ListNestedAttribute{
+				Description: "Time windows applied every day, a window ending before it starts ends on the next day.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleWindowAttributes(timeOfDay(), "17:30"),
+				},
+			},
+			"weekly": schema.SetNestedAttribute{
+				Description: "Time windows applied on given days of the week, a window ending before it starts ends on the next day.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleWindowAttributes(timeOfDay(), "17:30", map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Description: "Day of the week, e.g. monday.",
+							Required:    true,
+							Validators:  []validator.String{oneOf(scheduleDays...)},
+						},
+					}),
+				},
+			},
+			"non_recurring": schema.ListNestedAttribute{
+				Description: "Time intervals applied once. Conflicts with daily and weekly.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: scheduleWindowAttributes(dateTime(), "2024-01-31T17:30"),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the schedule is either recurring or non recurring
+// and that no window is empty. Daily and weekly windows may cross
+// midnight, non recurring ones must end after they start.
+func (r *scheduleResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config scheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recurring := config.Daily != nil || config.Weekly != nil
+	if recurring && config.NonRecurring != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("non_recurring"),
+			"Conflicting Attributes",
+			"Attribute non_recurring can't be combined with daily or weekly.")
+	} else if !recurring && config.NonRecurring == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("daily"),
+			"Missing Attribute",
+			"One of daily, weekly or non_recurring must be set.")
+	}
+
+	for key, val := range config.Daily {
+		checkScheduleWindow("Daily window", val.Start, val.End, timeOfDayLayout,
+			path.Root("daily").AtListIndex(key).AtName("end"), &resp.Diagnostics)
+	}
+	for _, val := range config.Weekly {
+		checkScheduleWindow("Weekly window on "+val.Day.ValueString(), val.Start, val.End, timeOfDayLayout,
+			path.Root("weekly"), &resp.Diagnostics)
+	}
+	for key, val := range config.NonRecurring {
+		checkScheduleWindow("Non recurring window", val.Start, val.End, dateTimeLayout,
+			path.Root("non_recurring").AtListIndex(key).AtName("end"), &resp.Diagnostics)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *scheduleResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *scheduleResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Schedule request received")
+
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgServiceObjSchedule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Schedule "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Schedule request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *scheduleResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Schedule request received")
+
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetDeviceOrganizationSchedule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Schedule "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Schedule "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*schedule)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Schedule request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *scheduleResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Schedule request received")
+
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgServiceObjSchedule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Schedule "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Schedule request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *scheduleResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Schedule request received")
+
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgServiceObjSchedule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Schedule "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Schedule request completed")
+}
+
+// scheduleWindowAttributes returns start and end attributes of a time
+// window validated by v, merged with additional attributes.
+func scheduleWindowAttributes(v validator.String, example string,
+	additional ...map[string]schema.Attribute) map[string]schema.Attribute {
+
+	attributes := map[string]schema.Attribute{
+		"start": schema.StringAttribute{
+			Description: "Start of the window, formatted like " + example + ".",
+			Required:    true,
+			Validators:  []validator.String{v},
+		},
+		"end": schema.StringAttribute{
+			Description: "End of the window, formatted like " + example + ".",
+			Required:    true,
+			Validators:  []validator.String{v},
+		},
+	}
+	for _, val := range additional {
+		for name, attribute := range val {
+			attributes[name] = attribute
+		}
+	}
+	return attributes
+}
+
+// checkScheduleWindow reports empty windows, and windows of layouts other
+// than the time of day which don't end after they start. Windows named
+// window are reported on attrPath.
+// Malformed times are reported by the attribute validators.
+func checkScheduleWindow(window string, start types.String, end types.String, layout string,
+	attrPath path.Path, diags *diag.Diagnostics) {
+
+	startTime, err := time.Parse(layout, start.ValueString())
+	if err != nil {
+		return
+	}
+	endTime, err := time.Parse(layout, end.ValueString())
+	if err != nil {
+		return
+	}
+	if endTime.Equal(startTime) {
+		diags.AddAttributeError(attrPath,
+			"Invalid Schedule Window",
+			fmt.Sprintf("%v end %v must differ from start %v.", window, end.ValueString(), start.ValueString()))
+	} else if layout != timeOfDayLayout && endTime.Before(startTime) {
+		diags.AddAttributeError(attrPath,
+			"Invalid Schedule Window",
+			fmt.Sprintf("%v end %v must be after start %v.", window, end.ValueString(), start.ValueString()))
+	}
+}
+
+// scheduleResourceModel maps the resource schema data.
+type scheduleResourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	DeviceName       types.String          `tfsdk:"device_name"`
+	OrganizationName types.String          `tfsdk:"organization_name"`
+	Name             types.String          `tfsdk:"name"`
+	Description      types.String          `tfsdk:"description"`
+	Tags             []types.String        `tfsdk:"tags"`
+	Daily            []scheduleWindowModel `tfsdk:"daily"`
+	Weekly           []scheduleWeeklyModel `tfsdk:"weekly"`
+	NonRecurring     []scheduleWindowModel `tfsdk:"non_recurring"`
+	LastUpdated      types.String          `tfsdk:"last_updated"`
+}
+
+// scheduleWindowModel maps daily and non_recurring windows.
+type scheduleWindowModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+// scheduleWeeklyModel maps weekly windows.
+type scheduleWeeklyModel struct {
+	Day   types.String `tfsdk:"day"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+func (m scheduleResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the schedule to the client request format.
+func (m scheduleResourceModel) toClient() vclient.DevObjectSchedule {
+	schedule := vclient.DevObjectSchedule{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+	}
+	if m.Daily != nil || m.Weekly != nil {
+		schedule.Recurring = &vclient.DevObjectScheduleRecurring{}
+	}
+	if m.Daily != nil {
+		schedule.Recurring.Daily = &vclient.DevObjectScheduleTimes{}
+		for _, val := range m.Daily {
+			schedule.Recurring.Daily.TimeOfDay = append(schedule.Recurring.Daily.TimeOfDay,
+				val.Start.ValueString()+"-"+val.End.ValueString())
+		}
+	}
+	if m.Weekly != nil {
+		schedule.Recurring.Weekly = map[string]vclient.DevObjectScheduleTimes{}
+		for _, val := range m.Weekly {
+			day := schedule.Recurring.Weekly[val.Day.ValueString()]
+			day.TimeOfDay = append(day.TimeOfDay, val.Start.ValueString()+"-"+val.End.ValueString())
+			schedule.Recurring.Weekly[val.Day.ValueString()] = day
+		}
+	}
+	for _, val := range m.NonRecurring {
+		schedule.NonRecurring = append(schedule.NonRecurring, vclient.DevObjectScheduleInterval{
+			Start: val.Start.ValueString(),
+			End:   val.End.ValueString(),
+		})
+	}
+	return schedule
+}
+
+// fromClient copies the schedule read from director.
+func (m *scheduleResourceModel) fromClient(schedule vclient.DevObjectSchedule) {
+	m.Name = types.StringValue(schedule.Name)
+	m.Description = stringValueOrNull(schedule.Description)
+	m.Tags = stringListValue(schedule.Tags, m.Tags)
+	m.Daily = nil
+	m.Weekly = nil
+	m.NonRecurring = nil
+	if schedule.Recurring != nil && schedule.Recurring.Daily != nil {
+		for _, val := range schedule.Recurring.Daily.TimeOfDay {
+			start, end, _ := strings.Cut(val, "-")
+			m.Daily = append(m.Daily, scheduleWindowModel{
+				Start: types.StringValue(start),
+				End:   types.StringValue(end),
+			})
+		}
+	}
+	if schedule.Recurring != nil {
+		for _, day := range scheduleDays {
+			for _, val := range schedule.Recurring.Weekly[day].TimeOfDay {
+				start, end, _ := strings.Cut(val, "-")
+				m.Weekly = append(m.Weekly, scheduleWeeklyModel{
+					Day:   types.StringValue(day),
+					Start: types.StringValue(start),
+					End:   types.StringValue(end),
+				})
+			}
+		}
+	}
+	for _, val := range schedule.NonRecurring {
+		m.NonRecurring = append(m.NonRecurring, scheduleWindowModel{
+			Start: types.StringValue(val.Start),
+			End:   types.StringValue(val.End),
+		})
+	}
+}
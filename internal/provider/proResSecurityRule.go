@@ -0,0 +1,604 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &securityRuleResource{}
+	_ resource.ResourceWithConfigure      = &securityRuleResource{}
+	_ resource.ResourceWithImportState    = &securityRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &securityRuleResource{}
+	_ resource.ResourceWithValidateConfig = &securityRuleResource{}
+)
+
+// rulePositions maps the position attribute of ordered rules to the
+// director insert position.
+var rulePositions = map[string]string{
+	"top":    vclient.PositionFirst,
+	"bottom": vclient.PositionLast,
+	"before": vclient.PositionBefore,
+	"after":  vclient.PositionAfter,
+}
+
+// NewSecurityRuleResource is a helper function to simplify the provider implementation.
+func NewSecurityRuleResource() resource.Resource {
+	return &securityRuleResource{}
+}
+
+// securityRuleResource manages an access rule of a security policy.
+type securityRuleResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/policy/name form.
+func (r *securityRuleResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importCompositeID(ctx, req, resp, "device_name", "organization_name", "policy_name", "name")
+}
+
+// Metadata returns the resource type name.
+func (r *securityRuleResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_security_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *securityRuleResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: ruleAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the rule in device/organization/policy/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_name": schema.StringAttribute{
+				Description: "Name of the security policy holding the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the rule.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the rule.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Keep the rule configured but don't evaluate it. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"source_zones": matchListAttribute("Source zones matched by the rule."),
+			"source_addresses": matchListAttribute(
+				"Source address objects matched by the rule."),
+			"source_address_groups": matchListAttribute(
+				"Source address groups matched by the rule."),
+			"users": matchListAttribute("Users matched by the rule."),
+			"destination_zones": matchListAttribute(
+				"Destination zones matched by the rule."),
+			"destination_addresses": matchListAttribute(
+				"Destination address objects matched by the rule."),
+			"destination_address_groups": matchListAttribute(
+				"Destination address groups matched by the rule."),
+			"services": matchListAttribute(
+				"Services, custom or predefined, matched by the rule."),
+			"service_groups": matchListAttribute("Service groups matched by the rule."),
+			"applications": matchListAttribute(
+				"Predefined application IDs matched by the rule, checked at plan time."),
+			"custom_applications": matchListAttribute(
+				"Custom applications matched by the rule."),
+			"url_categories": matchListAttribute(
+				"Predefined URL categories matched by the rule."),
+			"custom_url_categories": matchListAttribute(
+				"Custom URL categories matched by the rule."),
+			"action": schema.StringAttribute{
+				Description: "Action for matched traffic, one of allow, deny or reject.",
+				Required:    true,
+				Validators: []validator.String{oneOf(vclient.SecurityRuleActionAllow,
+					vclient.SecurityRuleActionDeny, vclient.SecurityRuleActionReject)},
+			},
+			"url_filtering_profile": schema.StringAttribute{
+				Description: "URL filtering profile applied to allowed traffic.",
+				Optional:    true,
+			},
+			"ips_profile": schema.StringAttribute{
+				Description: "IPS profile applied to allowed traffic.",
+				Optional:    true,
+			},
+			"antivirus_profile": schema.StringAttribute{
+				Description: "Antivirus profile applied to allowed traffic.",
+				Optional:    true,
+			},
+			"file_filtering_profile": schema.StringAttribute{
+				Description: "File filtering profile applied to allowed traffic.",
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// ruleAttributes adds the position and relative_to attributes of ordered
+// rules to attributes. Changing the position recreates the rule as
+// director only positions rules when they are created. Read leaves both
+// as they are, so rules moved on director afterwards aren't reported as
+// drift.
+func ruleAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["position"] = schema.StringAttribute{
+		Description: "Where to insert the rule when it is created: top, bottom, before or after. " +
+			"Rules are appended at the bottom when not set. Changing it recreates the rule, setting it " +
+			"on a rule created without it or imported is only recorded. Rules moved on director " +
+			"later aren't detected as drift.",
+		Optional:   true,
+		Validators: []validator.String{oneOf("top", "bottom", "before", "after")},
+		PlanModifiers: []planmodifier.String{
+			rulePositionRequiresReplace(),
+		},
+	}
+	attributes["relative_to"] = schema.StringAttribute{
+		Description: "Name of the rule to insert before or after, required with those positions.",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			rulePositionRequiresReplace(),
+		},
+	}
+	return attributes
+}
+
+// rulePositionRequiresReplace recreates a rule when its position changes.
+// Rules created without a position or imported hold none in state, which
+// isn't a change of where the rule is.
+func rulePositionRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest,
+			resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Changing the position recreates the rule, unless the rule has no position in state.",
+		"Changing the position recreates the rule, unless the rule has no position in state.",
+	)
+}
+
+// matchListAttribute returns an optional list of object names.
+func matchListAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: description,
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+}
+
+// validateRulePosition checks relative_to is set exactly for positions
+// relative to another rule.
+func validateRulePosition(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	var position, relativeTo types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("position"), &position)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("relative_to"), &relativeTo)...)
+	if resp.Diagnostics.HasError() || position.IsUnknown() || relativeTo.IsUnknown() {
+		return
+	}
+	relative := position.ValueString() == "before" || position.ValueString() == "after"
+	if relative && relativeTo.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("relative_to"),
+			"Missing Attribute",
+			"Attribute relative_to must be set when position is "+position.ValueString()+".")
+	} else if !relative && !relativeTo.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("relative_to"),
+			"Invalid Attribute Combination",
+			"Attribute relative_to can only be set when position is before or after.")
+	}
+}
+
+// rulePosition converts the position attributes to the client format.
+func rulePosition(position types.String, relativeTo types.String) vclient.ObjectPosition {
+	return vclient.ObjectPosition{
+		Insert: rulePositions[position.ValueString()],
+		Point:  relativeTo.ValueString(),
+	}
+}
+
+// ValidateConfig checks position attributes and that security profiles
+// are only attached to allow rules.
+func (r *securityRuleResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	validateRulePosition(ctx, req, resp)
+
+	var action types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("action"), &action)...)
+	if resp.Diagnostics.HasError() || action.IsUnknown() ||
+		action.ValueString() == vclient.SecurityRuleActionAllow {
+		return
+	}
+	for _, name := range []string{"url_filtering_profile", "ips_profile",
+		"antivirus_profile", "file_filtering_profile"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid Attribute Combination",
+				"Attribute "+name+" can only be set for allow rules.")
+		}
+	}
+}
+
+// ModifyPlan checks predefined applications matched by the rule are known
+// to director, so misspelled application IDs are reported at plan time.
+// Custom applications may be created by the same apply and aren't checked.
+func (r *securityRuleResource) ModifyPlan(ctx context.Context,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+
+	checkPredefinedApplications(ctx, r.client, req, resp)
+}
+
+// checkPredefinedApplications reports entries of the applications
+// attribute of a planned rule which aren't predefined applications.
+func checkPredefinedApplications(ctx context.Context, client *vclient.Client,
+	req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	var applications types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("applications"), &applications)...)
+	if resp.Diagnostics.HasError() || applications.IsNull() || applications.IsUnknown() {
+		return
+	}
+	var refs []types.String
+	resp.Diagnostics.Append(applications.ElementsAs(ctx, &refs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := client.GetAllPredefinedApplications(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error Reading Predefined Applications", err)
+		return
+	}
+	if len(apps) == 0 {
+		// Security pack not installed, nothing to check against
+		return
+	}
+	names := make([]string, 0, len(apps))
+	for _, val := range apps {
+		names = append(names, val.Name)
+	}
+	addMissingReferences(&resp.Diagnostics, path.Root("applications"), "Application", refs, names)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *securityRuleResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *securityRuleResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Security Rule request received")
+
+	var plan securityRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgSecurityRule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.PolicyName.ValueString(),
+		plan.toClient(), rulePosition(plan.Position, plan.RelativeTo))
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Security Rule "+plan.Name.ValueString()+" in Policy "+
+				plan.PolicyName.ValueString()+" for Device "+plan.DeviceName.ValueString()+
+				" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Security Rule request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *securityRuleResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Security Rule request received")
+
+	var state securityRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetDeviceOrganizationSecurityRule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.PolicyName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Security rule "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Security Rule "+state.Name.ValueString()+" in Policy "+
+				state.PolicyName.ValueString()+" for Device "+state.DeviceName.ValueString()+
+				" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*rule)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Security Rule request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *securityRuleResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Security Rule request received")
+
+	var plan securityRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgSecurityRule(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.PolicyName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Security Rule "+plan.Name.ValueString()+" in Policy "+
+				plan.PolicyName.ValueString()+" for Device "+plan.DeviceName.ValueString()+
+				" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Security Rule request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *securityRuleResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Security Rule request received")
+
+	var state securityRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgSecurityRule(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.PolicyName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Security Rule "+state.Name.ValueString()+" in Policy "+
+				state.PolicyName.ValueString()+" for Device "+state.DeviceName.ValueString()+
+				" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Security Rule request completed")
+}
+
+// securityRuleResourceModel maps the resource schema data.
+type securityRuleResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	DeviceName               types.String   `tfsdk:"device_name"`
+	OrganizationName         types.String   `tfsdk:"organization_name"`
+	PolicyName               types.String   `tfsdk:"policy_name"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	Tags                     []types.String `tfsdk:"tags"`
+	Disabled                 types.Bool     `tfsdk:"disabled"`
+	Position                 types.String   `tfsdk:"position"`
+	RelativeTo               types.String   `tfsdk:"relative_to"`
+	SourceZones              []types.String `tfsdk:"source_zones"`
+	SourceAddresses          []types.String `tfsdk:"source_addresses"`
+	SourceAddressGroups      []types.String `tfsdk:"source_address_groups"`
+	Users                    []types.String `tfsdk:"users"`
+	DestinationZones         []types.String `tfsdk:"destination_zones"`
+	DestinationAddresses     []types.String `tfsdk:"destination_addresses"`
+	DestinationAddressGroups []types.String `tfsdk:"destination_address_groups"`
+	Services                 []types.String `tfsdk:"services"`
+	ServiceGroups            []types.String `tfsdk:"service_groups"`
+	Applications             []types.String `tfsdk:"applications"`
+	CustomApplications       []types.String `tfsdk:"custom_applications"`
+	URLCategories            []types.String `tfsdk:"url_categories"`
+	CustomURLCategories      []types.String `tfsdk:"custom_url_categories"`
+	Action                   types.String   `tfsdk:"action"`
+	URLFilteringProfile      types.String   `tfsdk:"url_filtering_profile"`
+	IPSProfile               types.String   `tfsdk:"ips_profile"`
+	AntivirusProfile         types.String   `tfsdk:"antivirus_profile"`
+	FileFilteringProfile     types.String   `tfsdk:"file_filtering_profile"`
+	LastUpdated              types.String   `tfsdk:"last_updated"`
+}
+
+func (m securityRuleResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.PolicyName.ValueString()+"/"+m.Name.ValueString())
+}
+
+// toClient converts the rule to the client request format.
+func (m securityRuleResourceModel) toClient() vclient.DevSecurityRule {
+	rule := vclient.DevSecurityRule{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Tags:        stringList(m.Tags),
+		Disabled:    m.Disabled.ValueBool(),
+		Set: vclient.DevSecurityRuleSet{
+			Action: m.Action.ValueString(),
+		},
+	}
+
+	match := &rule.Match
+	if m.SourceZones != nil || m.SourceAddresses != nil || m.SourceAddressGroups != nil || m.Users != nil {
+		match.Source = &vclient.DevSecurityRuleEndpoint{
+			Zones:         stringList(m.SourceZones),
+			Addresses:     stringList(m.SourceAddresses),
+			AddressGroups: stringList(m.SourceAddressGroups),
+			Users:         stringList(m.Users),
+		}
+	}
+	if m.DestinationZones != nil || m.DestinationAddresses != nil || m.DestinationAddressGroups != nil {
+		match.Destination = &vclient.DevSecurityRuleEndpoint{
+			Zones:         stringList(m.DestinationZones),
+			Addresses:     stringList(m.DestinationAddresses),
+			AddressGroups: stringList(m.DestinationAddressGroups),
+		}
+	}
+	if m.Services != nil || m.ServiceGroups != nil {
+		match.Services = &vclient.DevSecurityRuleServices{
+			Services:      stringList(m.Services),
+			ServiceGroups: stringList(m.ServiceGroups),
+		}
+	}
+	if m.Applications != nil || m.CustomApplications != nil {
+		match.Application = &vclient.DevSecurityRuleNames{
+			Predefined:  stringList(m.Applications),
+			UserDefined: stringList(m.CustomApplications),
+		}
+	}
+	if m.URLCategories != nil || m.CustomURLCategories != nil {
+		match.URLCategory = &vclient.DevSecurityRuleNames{
+			Predefined:  stringList(m.URLCategories),
+			UserDefined: stringList(m.CustomURLCategories),
+		}
+	}
+
+	profiles := vclient.DevSecurityRuleProfiles{
+		URLFiltering:  m.URLFilteringProfile.ValueString(),
+		IPS:           m.IPSProfile.ValueString(),
+		Antivirus:     m.AntivirusProfile.ValueString(),
+		FileFiltering: m.FileFilteringProfile.ValueString(),
+	}
+	if profiles != (vclient.DevSecurityRuleProfiles{}) {
+		rule.Set.SecurityProfile = &profiles
+	}
+	return rule
+}
+
+// fromClient copies the rule read from director. Position attributes
+// only apply on create and are kept as they are.
+func (m *securityRuleResourceModel) fromClient(rule vclient.DevSecurityRule) {
+	m.Name = types.StringValue(rule.Name)
+	m.Description = stringValueOrNull(rule.Description)
+	m.Tags = stringListValue(rule.Tags, m.Tags)
+	m.Disabled = types.BoolValue(rule.Disabled)
+	m.Action = types.StringValue(rule.Set.Action)
+
+	source := vclient.DevSecurityRuleEndpoint{}
+	if rule.Match.Source != nil {
+		source = *rule.Match.Source
+	}
+	m.SourceZones = stringListValue(source.Zones, m.SourceZones)
+	m.SourceAddresses = stringListValue(source.Addresses, m.SourceAddresses)
+	m.SourceAddressGroups = stringListValue(source.AddressGroups, m.SourceAddressGroups)
+	m.Users = stringListValue(source.Users, m.Users)
+
+	destination := vclient.DevSecurityRuleEndpoint{}
+	if rule.Match.Destination != nil {
+		destination = *rule.Match.Destination
+	}
+	m.DestinationZones = stringListValue(destination.Zones, m.DestinationZones)
+	m.DestinationAddresses = stringListValue(destination.Addresses, m.DestinationAddresses)
+	m.DestinationAddressGroups = stringListValue(destination.AddressGroups, m.DestinationAddressGroups)
+
+	services := vclient.DevSecurityRuleServices{}
+	if rule.Match.Services != nil {
+		services = *rule.Match.Services
+	}
+	m.Services = stringListValue(services.Services, m.Services)
+	m.ServiceGroups = stringListValue(services.ServiceGroups, m.ServiceGroups)
+
+	applications := vclient.DevSecurityRuleNames{}
+	if rule.Match.Application != nil {
+		applications = *rule.Match.Application
+	}
+	m.Applications = stringListValue(applications.Predefined, m.Applications)
+	m.CustomApplications = stringListValue(applications.UserDefined, m.CustomApplications)
+
+	urlCategories := vclient.DevSecurityRuleNames{}
+	if rule.Match.URLCategory != nil {
+		urlCategories = *rule.Match.URLCategory
+	}
+	m.URLCategories = stringListValue(urlCategories.Predefined, m.URLCategories)
+	m.CustomURLCategories = stringListValue(urlCategories.UserDefined, m.CustomURLCategories)
+
+	profiles := vclient.DevSecurityRuleProfiles{}
+	if rule.Set.SecurityProfile != nil {
+		profiles = *rule.Set.SecurityProfile
+	}
+	m.URLFilteringProfile = stringValueOrNull(profiles.URLFiltering)
+	m.IPSProfile = stringValueOrNull(profiles.IPS)
+	m.AntivirusProfile = stringValueOrNull(profiles.Antivirus)
+	m.FileFilteringProfile = stringValueOrNull(profiles.FileFiltering)
+}
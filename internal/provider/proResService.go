@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &serviceResource{}
+	_ resource.ResourceWithConfigure      = &serviceResource{}
+	_ resource.ResourceWithImportState    = &serviceResource{}
+	_ resource.ResourceWithValidateConfig = &serviceResource{}
+)
+
+// serviceProtocols lists protocols which can be selected by name, others
+// are configured with protocol_number.
+var serviceProtocols = []string{"tcp", "udp", "sctp", "icmp", "icmpv6"}
+
+// NewServiceResource is a helper function to simplify the provider implementation.
+func NewServiceResource() resource.Resource {
+	return &serviceResource{}
+}
+
+// serviceResource manages a custom service of a device organization.
+type serviceResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *serviceResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *serviceResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the service in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the service.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the service.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the service.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Protocol of the service, one of tcp, udp, sctp, icmp or icmpv6. Conflicts with protocol_number.",
+				Optional:    true,
+				Validators:  []validator.String{oneOf(serviceProtocols...)},
+			},
+			"protocol_number": schema.Int64Attribute{
+				Description: "IP protocol number of the service, e.g. 47 for GRE. Conflicts with protocol.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(0, 255)},
+			},
+			"source_port": schema.StringAttribute{
+				Description: "Source port or port range such as 1024-2048, only for tcp, udp and sctp.",
+				Optional:    true,
+				Validators:  []validator.String{portRange()},
+			},
+			"destination_port": schema.StringAttribute{
+				Description: "Destination port or port range such as 1024-2048, only for tcp, udp and sctp.",
+				Optional:    true,
+				Validators:  []validator.String{portRange()},
+			},
+			"icmp_type": schema.Int64Attribute{
+				Description: "ICMP type matched by the service, only for icmp and icmpv6.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(0, 255)},
+			},
+			"icmp_code": schema.Int64Attribute{
+				Description: "ICMP code matched by the service, only for icmp and icmpv6.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64Between(0, 255)},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the protocol is set once and that port and ICMP
+// attributes match it.
+func (r *serviceResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config serviceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Protocol.IsNull() && !config.ProtocolNumber.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("protocol_number"),
+			"Conflicting Attributes",
+			"Only one of protocol and protocol_number can be set.")
+	} else if config.Protocol.IsNull() && config.ProtocolNumber.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("protocol"),
+			"Missing Attribute",
+			"One of protocol and protocol_number must be set.")
+	}
+	if config.Protocol.IsUnknown() {
+		return
+	}
+
+	protocol := config.Protocol.ValueString()
+	ports := protocol == "tcp" || protocol == "udp" || protocol == "sctp"
+	icmp := protocol == "icmp" || protocol == "icmpv6"
+	for name, value := range map[string]types.String{
+		"source_port": config.SourcePort, "destination_port": config.DestinationPort} {
+		if !ports && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid Attribute Combination",
+				"Attribute "+name+" can only be set for tcp, udp and sctp services.")
+		}
+	}
+	for name, value := range map[string]types.Int64{
+		"icmp_type": config.ICMPType, "icmp_code": config.ICMPCode} {
+		if !icmp && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name),
+				"Invalid Attribute Combination",
+				"Attribute "+name+" can only be set for icmp and icmpv6 services.")
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE Service request received")
+
+	var plan serviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgServiceObjService(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating Service "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE Service request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ Service request received")
+
+	var state serviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetDeviceOrganizationService(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "Service "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading Service "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*service)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ Service request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE Service request received")
+
+	var plan serviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgServiceObjService(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating Service "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE Service request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE Service request received")
+
+	var state serviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgServiceObjService(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting Service "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE Service request completed")
+}
+
+// serviceResourceModel maps the resource schema data.
+type serviceResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	DeviceName       types.String   `tfsdk:"device_name"`
+	OrganizationName types.String   `tfsdk:"organization_name"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	Tags             []types.String `tfsdk:"tags"`
+	Protocol         types.String   `tfsdk:"protocol"`
+	ProtocolNumber   types.Int64    `tfsdk:"protocol_number"`
+	SourcePort       types.String   `tfsdk:"source_port"`
+	DestinationPort  types.String   `tfsdk:"destination_port"`
+	ICMPType         types.Int64    `tfsdk:"icmp_type"`
+	ICMPCode         types.Int64    `tfsdk:"icmp_code"`
+	LastUpdated      types.String   `tfsdk:"last_updated"`
+}
+
+func (m serviceResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the service to the client request format.
+func (m serviceResourceModel) toClient() vclient.DevObjectService {
+	service := vclient.DevObjectService{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		Tags:            stringList(m.Tags),
+		Protocol:        m.Protocol.ValueString(),
+		ProtocolValue:   int64Pointer(m.ProtocolNumber),
+		SourcePort:      m.SourcePort.ValueString(),
+		DestinationPort: m.DestinationPort.ValueString(),
+	}
+	if !m.ICMPType.IsNull() || !m.ICMPCode.IsNull() {
+		service.ICMP = &vclient.DevObjectServiceICMP{
+			Type: int64Pointer(m.ICMPType),
+			Code: int64Pointer(m.ICMPCode),
+		}
+	}
+	return service
+}
+
+// fromClient copies the service read from director.
+func (m *serviceResourceModel) fromClient(service vclient.DevObjectService) {
+	m.Name = types.StringValue(service.Name)
+	m.Description = stringValueOrNull(service.Description)
+	m.Tags = stringListValue(service.Tags, m.Tags)
+	m.Protocol = stringValueOrNull(service.Protocol)
+	m.ProtocolNumber = int64ValueOrNull(service.ProtocolValue)
+	m.SourcePort = stringValueOrNull(service.SourcePort)
+	m.DestinationPort = stringValueOrNull(service.DestinationPort)
+	m.ICMPType = types.Int64Null()
+	m.ICMPCode = types.Int64Null()
+	if service.ICMP != nil {
+		m.ICMPType = int64ValueOrNull(service.ICMP.Type)
+		m.ICMPCode = int64ValueOrNull(service.ICMP.Code)
+	}
+}
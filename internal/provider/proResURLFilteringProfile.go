@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"versa-networks.com/vclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &urlFilteringProfileResource{}
+	_ resource.ResourceWithConfigure      = &urlFilteringProfileResource{}
+	_ resource.ResourceWithImportState    = &urlFilteringProfileResource{}
+	_ resource.ResourceWithValidateConfig = &urlFilteringProfileResource{}
+)
+
+// NewURLFilteringProfileResource is a helper function to simplify the provider implementation.
+func NewURLFilteringProfileResource() resource.Resource {
+	return &urlFilteringProfileResource{}
+}
+
+// urlFilteringProfileResource manages a URL filtering profile of a device organization.
+type urlFilteringProfileResource struct {
+	client *vclient.Client
+}
+
+// ImportState accepts the resource ID in device/organization/name form.
+func (r *urlFilteringProfileResource) ImportState(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importDevOrgObjectID(ctx, req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *urlFilteringProfileResource) Metadata(_ context.Context,
+	req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	resp.TypeName = req.ProviderTypeName + "_url_filtering_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *urlFilteringProfileResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the URL filtering profile in device/organization/name form.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "Device name to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_name": schema.StringAttribute{
+				Description: "Organization name for the device to be configured.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the URL filtering profile.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the profile.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags of the profile.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_action": schema.StringAttribute{
+				Description: "Action for URLs matching no list or category action, one of " +
+					strings.Join(urlFilteringActions, ", ") + ". Defaults to allow.",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("allow"),
+				Validators: []validator.String{oneOf(urlFilteringActions...)},
+			},
+			"category_actions": schema.ListNestedAttribute{
+				Description: "Actions for URL categories.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the category action.",
+							Required:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action for URLs of the categories, one of " +
+								strings.Join(urlFilteringActions, ", ") + ".",
+							Required:   true,
+							Validators: []validator.String{oneOf(urlFilteringActions...)},
+						},
+						"url_categories": schema.ListAttribute{
+							Description: "Predefined URL categories.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"custom_url_categories": schema.ListAttribute{
+							Description: "Custom URL categories.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"allow_list": schema.ListAttribute{
+				Description: "URL patterns which are always allowed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"deny_list": schema.ListAttribute{
+				Description: "URL patterns which are always blocked.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks every category action names at least one category.
+func (r *urlFilteringProfileResource) ValidateConfig(ctx context.Context,
+	req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config urlFilteringProfileResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, val := range config.CategoryActions {
+		if val.URLCategories == nil && val.CustomURLCategories == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("category_actions").AtListIndex(key),
+				"Missing Attribute",
+				"One of url_categories or custom_url_categories must be set.")
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *urlFilteringProfileResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.client = configureClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *urlFilteringProfileResource) Create(ctx context.Context,
+	req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	tflog.Debug(ctx, "CREATE URL Filtering Profile request received")
+
+	var plan urlFilteringProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDevOrgURLFilteringProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Creating URL Filtering Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "CREATE URL Filtering Profile request completed")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *urlFilteringProfileResource) Read(ctx context.Context,
+	req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	tflog.Debug(ctx, "READ URL Filtering Profile request received")
+
+	var state urlFilteringProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetDeviceOrganizationURLFilteringProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if vclient.IsNotFound(err) {
+		tflog.Debug(ctx, "URL filtering profile "+state.id()+" not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Reading URL Filtering Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+
+	state.fromClient(*profile)
+	state.ID = types.StringValue(state.id())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "READ URL Filtering Profile request completed")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *urlFilteringProfileResource) Update(ctx context.Context,
+	req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	tflog.Debug(ctx, "UPDATE URL Filtering Profile request received")
+
+	var plan urlFilteringProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDevOrgURLFilteringProfile(ctx, plan.DeviceName.ValueString(),
+		plan.OrganizationName.ValueString(), plan.toClient())
+	if err != nil {
+		addClientError(&resp.Diagnostics,
+			"Error Updating URL Filtering Profile "+plan.Name.ValueString()+" for Device "+
+				plan.DeviceName.ValueString()+" Organization "+plan.OrganizationName.ValueString(), err)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	tflog.Debug(ctx, "UPDATE URL Filtering Profile request completed")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *urlFilteringProfileResource) Delete(ctx context.Context,
+	req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	tflog.Debug(ctx, "DELETE URL Filtering Profile request received")
+
+	var state urlFilteringProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDevOrgURLFilteringProfile(ctx, state.DeviceName.ValueString(),
+		state.OrganizationName.ValueString(), state.Name.ValueString())
+	if err != nil && !vclient.IsNotFound(err) {
+		addClientError(&resp.Diagnostics,
+			"Error Deleting URL Filtering Profile "+state.Name.ValueString()+" for Device "+
+				state.DeviceName.ValueString()+" Organization "+state.OrganizationName.ValueString(), err)
+		return
+	}
+	tflog.Debug(ctx, "DELETE URL Filtering Profile request completed")
+}
+
+// urlFilteringActions are the actions of URL filtering profiles.
+var urlFilteringActions = []string{"allow", "alert", "ask", "block", "inform", "justify"}
+
+// urlFilteringProfileResourceModel maps the resource schema data.
+type urlFilteringProfileResourceModel struct {
+	ID               types.String                      `tfsdk:"id"`
+	DeviceName       types.String                      `tfsdk:"device_name"`
+	OrganizationName types.String                      `tfsdk:"organization_name"`
+	Name             types.String                      `tfsdk:"name"`
+	Description      types.String                      `tfsdk:"description"`
+	Tags             []types.String                    `tfsdk:"tags"`
+	DefaultAction    types.String                      `tfsdk:"default_action"`
+	CategoryActions  []urlFilteringCategoryActionModel `tfsdk:"category_actions"`
+	AllowList        []types.String                    `tfsdk:"allow_list"`
+	DenyList         []types.String                    `tfsdk:"deny_list"`
+	LastUpdated      types.String                      `tfsdk:"last_updated"`
+}
+
+// urlFilteringCategoryActionModel maps category action schema data.
+type urlFilteringCategoryActionModel struct {
+	Name                types.String   `tfsdk:"name"`
+	Action              types.String   `tfsdk:"action"`
+	URLCategories       []types.String `tfsdk:"url_categories"`
+	CustomURLCategories []types.String `tfsdk:"custom_url_categories"`
+}
+
+func (m urlFilteringProfileResourceModel) id() string {
+	return devOrgObjectID(m.DeviceName.ValueString(), m.OrganizationName.ValueString(),
+		m.Name.ValueString())
+}
+
+// toClient converts the profile to the client request format.
+func (m urlFilteringProfileResourceModel) toClient() vclient.DevURLFilteringProfile {
+	profile := vclient.DevURLFilteringProfile{
+		Name:          m.Name.ValueString(),
+		Description:   m.Description.ValueString(),
+		Tags:          stringList(m.Tags),
+		DefaultAction: m.DefaultAction.ValueString(),
+	}
+	for _, val := range m.CategoryActions {
+		profile.CategoryActions = append(profile.CategoryActions, vclient.DevURLFilteringCategoryAction{
+			Name:   val.Name.ValueString(),
+			Action: val.Action.ValueString(),
+			URLCategories: &vclient.DevSecurityRuleNames{
+				Predefined:  stringList(val.URLCategories),
+				UserDefined: stringList(val.CustomURLCategories),
+			},
+		})
+	}
+	if m.AllowList != nil {
+		profile.AllowList = &vclient.DevURLFilteringPatterns{Patterns: stringList(m.AllowList)}
+	}
+	if m.DenyList != nil {
+		profile.DenyList = &vclient.DevURLFilteringPatterns{Patterns: stringList(m.DenyList)}
+	}
+	return profile
+}
+
+// fromClient copies the profile read from director.
+func (m *urlFilteringProfileResourceModel) fromClient(profile vclient.DevURLFilteringProfile) {
+	m.Name = types.StringValue(profile.Name)
+	m.Description = stringValueOrNull(profile.Description)
+	m.Tags = stringListValue(profile.Tags, m.Tags)
+	m.DefaultAction = types.StringValue(profile.DefaultAction)
+
+	prior := m.CategoryActions
+	m.CategoryActions = nil
+	for key, val := range profile.CategoryActions {
+		var previous urlFilteringCategoryActionModel
+		if key < len(prior) {
+			previous = prior[key]
+		}
+		categories := vclient.DevSecurityRuleNames{}
+		if val.URLCategories != nil {
+			categories = *val.URLCategories
+		}
+		m.CategoryActions = append(m.CategoryActions, urlFilteringCategoryActionModel{
+			Name:                types.StringValue(val.Name),
+			Action:              types.StringValue(val.Action),
+			URLCategories:       stringListValue(categories.Predefined, previous.URLCategories),
+			CustomURLCategories: stringListValue(categories.UserDefined, previous.CustomURLCategories),
+		})
+	}
+
+	var allowList, denyList []string
+	if profile.AllowList != nil {
+		allowList = profile.AllowList.Patterns
+	}
+	if profile.DenyList != nil {
+		denyList = profile.DenyList.Patterns
+	}
+	m.AllowList = stringListValue(allowList, m.AllowList)
+	m.DenyList = stringListValue(denyList, m.DenyList)
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"versa-networks.com/vclient"
+)
+
+// filterStringMatch reports whether value satisfies the optional filter
+// attribute. A null or unknown filter matches everything, otherwise the
+// comparison is case-insensitive.
+func filterStringMatch(filter types.String, value string) bool {
+	if filter.IsNull() || filter.IsUnknown() {
+		return true
+	}
+	return strings.EqualFold(filter.ValueString(), value)
+}
+
+// clientErrorDetail renders an error returned by vclient as diagnostic
+// detail, spelling out the director response when one was received.
+func clientErrorDetail(err error) string {
+	var apiErr *vclient.APIError
+	if !errors.As(err, &apiErr) {
+		return "versaDirector Client Error: " + err.Error()
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "versaDirector returned %v for %v %v.", apiErr.Status, apiErr.Method, apiErr.URL)
+	if len(apiErr.Code) > 0 {
+		fmt.Fprintf(&detail, "\n\nError Code: %v", apiErr.Code)
+	}
+	if len(apiErr.Message) > 0 {
+		fmt.Fprintf(&detail, "\nError Message: %v", apiErr.Message)
+	} else if len(apiErr.Body) > 0 {
+		fmt.Fprintf(&detail, "\nResponse: %v", apiErr.Body)
+	}
+	if len(apiErr.RequestID) > 0 {
+		fmt.Fprintf(&detail, "\nRequest ID: %v", apiErr.RequestID)
+	}
+	return detail.String()
+}
+
+// addClientError appends an error diagnostic for a failed vclient call.
+func addClientError(diags *diag.Diagnostics, summary string, err error) {
+	diags.AddError(summary, clientErrorDetail(err))
+}
+
+// stringValueOrNull maps empty strings received from director to null so
+// that omitted optional attributes do not show up as changes.
+func stringValueOrNull(value string) types.String {
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringList converts a list attribute to the client format.
+func stringList(values []types.String) []string {
+	var list []string
+	for _, val := range values {
+		list = append(list, val.ValueString())
+	}
+	return list
+}
+
+// stringListValue converts a list received from director to a list
+// attribute. Director omits empty lists, so an empty list is null unless
+// previous, the prior value of the attribute, was an empty list.
+func stringListValue(values []string, previous []types.String) []types.String {
+	if len(values) == 0 && previous != nil {
+		return []types.String{}
+	}
+	var list []types.String
+	for _, val := range values {
+		list = append(list, types.StringValue(val))
+	}
+	return list
+}
+
+// int64ListValue is stringListValue for lists of numbers.
+func int64ListValue(values []int64, previous []types.Int64) []types.Int64 {
+	if len(values) == 0 && previous != nil {
+		return []types.Int64{}
+	}
+	var list []types.Int64
+	for _, val := range values {
+		list = append(list, types.Int64Value(val))
+	}
+	return list
+}
+
+// configureClient returns the vclient passed by the provider to resources
+// and data sources, nil before the provider is configured.
+func configureClient(providerData any, diags *diag.Diagnostics) *vclient.Client {
+	if providerData == nil {
+		return nil
+	}
+
+	client, ok := providerData.(*vclient.Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *vclient.Client, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+	}
+	return client
+}
+
+// devOrgObjectID builds the identifier of an org-services object.
+func devOrgObjectID(deviceName string, organizationName string, name string) string {
+	return deviceName + "/" + organizationName + "/" + name
+}
+
+// importDevOrgObjectID parses an import identifier in device/organization/name
+// form into the id, device_name, organization_name and name attributes.
+func importDevOrgObjectID(ctx context.Context,
+	req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	importCompositeID(ctx, req, resp, "device_name", "organization_name", "name")
+}
+
+// importCompositeID parses an import identifier made of slash separated
+// parts, one for each of attributes, and sets them along with id.
+func importCompositeID(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse, attributes ...string) {
+
+	parts := strings.Split(req.ID, "/")
+	valid := len(parts) == len(attributes)
+	for _, val := range parts {
+		valid = valid && len(val) > 0
+	}
+	if !valid {
+		format := strings.Join(attributes, "/")
+		format = strings.ReplaceAll(strings.ReplaceAll(format, "_name", ""), "_", " ")
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	for key, val := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(val), parts[key])...)
+	}
+}
+
+// addMissingReferences reports references to objects which are not part
+// of names, the list of objects configured on the device.
+func addMissingReferences(diags *diag.Diagnostics, attrPath path.Path,
+	kind string, refs []types.String, names []string) {
+
+	for _, key := range missingReferences(refs, names) {
+		diags.AddAttributeError(attrPath.AtListIndex(key),
+			"Missing Referenced Object",
+			fmt.Sprintf("%s %q referenced by the configuration does not exist on the device.",
+				kind, refs[key].ValueString()))
+	}
+}
+
+// missingReferences returns the indexes of known references which are
+// not part of names.
+func missingReferences(refs []types.String, names []string) []int {
+	var missing []int
+	for key, ref := range refs {
+		if ref.IsNull() || ref.IsUnknown() {
+			continue
+		}
+		found := false
+		for _, name := range names {
+			if name == ref.ValueString() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// int64Pointer converts an optional integer attribute to the client
+// format, null becomes nil.
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	number := value.ValueInt64()
+	return &number
+}
+
+// int64ValueOrNull converts an optional integer received from director.
+func int64ValueOrNull(value *int64) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+// bandwidthUnits are the units accepted in bandwidth attributes, in kbps.
+var bandwidthUnits = []struct {
+	unit string
+	kbps int64
+}{
+	{"Gbps", 1000000},
+	{"Mbps", 1000},
+	{"kbps", 1},
+}
+
+// parseBandwidth converts a bandwidth such as 500kbps, 20Mbps or 1Gbps to
+// kbps as used by director. Units are case-insensitive.
+func parseBandwidth(value string) (int64, error) {
+	for _, val := range bandwidthUnits {
+		if len(value) <= len(val.unit) ||
+			!strings.EqualFold(value[len(value)-len(val.unit):], val.unit) {
+			continue
+		}
+		digits := value[:len(value)-len(val.unit)]
+		number, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil || number <= 0 || digits[0] < '0' || digits[0] > '9' {
+			return 0, fmt.Errorf("invalid bandwidth %q", value)
+		}
+		if number > math.MaxInt64/val.kbps {
+			return 0, fmt.Errorf("bandwidth %q is too large", value)
+		}
+		return number * val.kbps, nil
+	}
+	return 0, fmt.Errorf("bandwidth %q doesn't end with kbps, Mbps or Gbps", value)
+}
+
+// bandwidthValue converts kbps received from director to a bandwidth
+// attribute. Previous keeps the value written by the user as long as it
+// still means the same rate, otherwise the largest exact unit is used.
+func bandwidthValue(kbps *int64, previous types.String) types.String {
+	if kbps == nil {
+		return types.StringNull()
+	}
+	if rate, err := parseBandwidth(previous.ValueString()); err == nil && rate == *kbps {
+		return previous
+	}
+	for _, val := range bandwidthUnits {
+		if *kbps%val.kbps == 0 {
+			return types.StringValue(strconv.FormatInt(*kbps/val.kbps, 10) + val.unit)
+		}
+	}
+	return types.StringValue(strconv.FormatInt(*kbps, 10) + "kbps")
+}
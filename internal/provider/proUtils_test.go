@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		value string
+		kbps  int64
+		fails bool
+	}{
+		{value: "500kbps", kbps: 500},
+		{value: "20Mbps", kbps: 20000},
+		{value: "1Gbps", kbps: 1000000},
+		{value: "2gbps", kbps: 2000000},
+		{value: "9223372036854775807kbps", kbps: 9223372036854775807},
+		{value: "9223372036854775Mbps", kbps: 9223372036854775000},
+		{value: "9223372036854776Mbps", fails: true},
+		{value: "9223372036855Gbps", fails: true},
+		{value: "+5Mbps", fails: true},
+		{value: "-5Mbps", fails: true},
+		{value: "0kbps", fails: true},
+		{value: " 5Mbps", fails: true},
+		{value: "1.5Gbps", fails: true},
+		{value: "Mbps", fails: true},
+		{value: "5", fails: true},
+		{value: "5Tbps", fails: true},
+		{value: "", fails: true},
+	}
+	for _, test := range tests {
+		kbps, err := parseBandwidth(test.value)
+		if test.fails {
+			if err == nil {
+				t.Errorf("%q: got %v, want error", test.value, kbps)
+			}
+		} else if err != nil || kbps != test.kbps {
+			t.Errorf("%q: got %v, %v, want %v", test.value, kbps, err, test.kbps)
+		}
+	}
+}
+
+func TestBandwidthValue(t *testing.T) {
+	kbps := func(value int64) *int64 { return &value }
+	tests := []struct {
+		kbps     *int64
+		previous types.String
+		want     types.String
+	}{
+		{kbps: nil, previous: types.StringValue("1Gbps"), want: types.StringNull()},
+		{kbps: kbps(1000000), previous: types.StringNull(), want: types.StringValue("1Gbps")},
+		{kbps: kbps(1000000), previous: types.StringValue("1000Mbps"), want: types.StringValue("1000Mbps")},
+		{kbps: kbps(1000000), previous: types.StringValue("1gbps"), want: types.StringValue("1gbps")},
+		{kbps: kbps(2000000), previous: types.StringValue("1Gbps"), want: types.StringValue("2Gbps")},
+		{kbps: kbps(1500000), previous: types.StringNull(), want: types.StringValue("1500Mbps")},
+		{kbps: kbps(1500), previous: types.StringValue("invalid"), want: types.StringValue("1500kbps")},
+	}
+	for _, test := range tests {
+		if got := bandwidthValue(test.kbps, test.previous); !got.Equal(test.want) {
+			t.Errorf("%v, %v: got %v, want %v", test.kbps, test.previous, got, test.want)
+		}
+	}
+}
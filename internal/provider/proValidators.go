@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String  = ipValidator{}
+	_ validator.Object  = atMostOneOfValidator{}
+	_ validator.String  = oneOfValidator{}
+	_ validator.List    = listElementValidator{}
+	_ validator.String  = portRangeValidator{}
+	_ validator.Int64   = int64BetweenValidator{}
+	_ validator.List    = listInt64BetweenValidator{}
+	_ validator.Float64 = float64BetweenValidator{}
+	_ validator.String  = timeFormatValidator{}
+	_ validator.String  = bandwidthValidator{}
+)
+
+// Time layouts accepted by director for schedules.
+const (
+	timeOfDayLayout = "15:04"
+	dateTimeLayout  = "2006-01-02T15:04"
+)
+
+// ipValidator checks a string attribute holds an IP address or prefix of
+// the expected family.
+type ipValidator struct {
+	ipv6   bool
+	prefix bool
+}
+
+func (v ipValidator) Description(_ context.Context) string {
+	family := "IPv4"
+	if v.ipv6 {
+		family = "IPv6"
+	}
+	if v.prefix {
+		return "value must be an " + family + " prefix in CIDR notation"
+	}
+	return "value must be an " + family + " address"
+}
+
+func (v ipValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+
+	var ip net.IP
+	if v.prefix {
+		prefixIP, _, err := net.ParseCIDR(value)
+		if err == nil {
+			ip = prefixIP
+		}
+	} else {
+		ip = net.ParseIP(value)
+	}
+	if ip == nil || (ip.To4() == nil) != v.ipv6 {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value))
+	}
+}
+
+// ipv4AddressValidator accepts IPv4 addresses such as 10.1.1.1.
+func ipv4AddressValidator() validator.String {
+	return ipValidator{}
+}
+
+// ipv4PrefixValidator accepts IPv4 prefixes such as 10.1.0.0/16.
+func ipv4PrefixValidator() validator.String {
+	return ipValidator{prefix: true}
+}
+
+// ipv6PrefixValidator accepts IPv6 prefixes such as 2001:db8::/32.
+func ipv6PrefixValidator() validator.String {
+	return ipValidator{ipv6: true, prefix: true}
+}
+
+// atMostOneOfValidator checks that at most one of the named attributes of
+// an object is set.
+type atMostOneOfValidator struct {
+	attributes []string
+}
+
+func (v atMostOneOfValidator) Description(_ context.Context) string {
+	return "only one of " + strings.Join(v.attributes, ", ") + " can be set"
+}
+
+func (v atMostOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v atMostOneOfValidator) ValidateObject(ctx context.Context,
+	req validator.ObjectRequest, resp *validator.ObjectResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var set []string
+	attributes := req.ConfigValue.Attributes()
+	for _, name := range v.attributes {
+		if value, ok := attributes[name]; ok && !value.IsNull() {
+			set = append(set, name)
+		}
+	}
+
+	if len(set) > 1 {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Conflicting Attributes",
+			fmt.Sprintf("Attribute %s: %s, got: %s", req.Path, v.Description(ctx), strings.Join(set, ", ")))
+	}
+}
+
+// atMostOneOf rejects objects setting more than one of attributes.
+func atMostOneOf(attributes ...string) validator.Object {
+	return atMostOneOfValidator{attributes: attributes}
+}
+
+// oneOfValidator checks a string attribute holds one of the allowed values.
+type oneOfValidator struct {
+	values []string
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	for _, val := range v.values {
+		if val == value {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value))
+}
+
+// oneOf accepts only the given values.
+func oneOf(values ...string) validator.String {
+	return oneOfValidator{values: values}
+}
+
+// listElementValidator applies a string validator to every element of a
+// string list attribute.
+type listElementValidator struct {
+	element validator.String
+}
+
+func (v listElementValidator) Description(ctx context.Context) string {
+	return "each " + v.element.Description(ctx)
+}
+
+func (v listElementValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listElementValidator) ValidateList(ctx context.Context,
+	req validator.ListRequest, resp *validator.ListResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for key, elem := range req.ConfigValue.Elements() {
+		value, ok := elem.(types.String)
+		if !ok {
+			continue
+		}
+		elemReq := validator.StringRequest{
+			Path:        req.Path.AtListIndex(key),
+			ConfigValue: value,
+		}
+		elemResp := validator.StringResponse{}
+		v.element.ValidateString(ctx, elemReq, &elemResp)
+		resp.Diagnostics.Append(elemResp.Diagnostics...)
+	}
+}
+
+// listOneOf accepts lists holding only the given values.
+func listOneOf(values ...string) validator.List {
+	return listElementValidator{element: oneOfValidator{values: values}}
+}
+
+// listIPv4Prefixes accepts lists of IPv4 prefixes.
+func listIPv4Prefixes() validator.List {
+	return listElementValidator{element: ipValidator{prefix: true}}
+}
+
+// portRangeValidator checks a string attribute holds a port or an
+// inclusive port range such as 1024-2048.
+type portRangeValidator struct{}
+
+func (v portRangeValidator) Description(_ context.Context) string {
+	return "value must be a port or a port range such as 1024-2048, ports between 0 and 65535"
+}
+
+func (v portRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangeValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+
+	low, high, isRange := strings.Cut(value, "-")
+	lowPort, err := strconv.ParseUint(low, 10, 16)
+	valid := err == nil
+	if valid && isRange {
+		highPort, err := strconv.ParseUint(high, 10, 16)
+		valid = err == nil && highPort >= lowPort
+	}
+	if !valid {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value))
+	}
+}
+
+// portRange accepts ports and port ranges.
+func portRange() validator.String {
+	return portRangeValidator{}
+}
+
+// int64BetweenValidator checks an integer attribute is within bounds.
+type int64BetweenValidator struct {
+	min int64
+	max int64
+}
+
+func (v int64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", v.min, v.max)
+}
+
+func (v int64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64BetweenValidator) ValidateInt64(ctx context.Context,
+	req validator.Int64Request, resp *validator.Int64Response) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueInt64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, v.Description(ctx), value))
+	}
+}
+
+// int64Between accepts integers from min to max inclusive.
+func int64Between(min int64, max int64) validator.Int64 {
+	return int64BetweenValidator{min: min, max: max}
+}
+
+// listInt64BetweenValidator checks every element of an integer list
+// attribute is within bounds.
+type listInt64BetweenValidator struct {
+	element int64BetweenValidator
+}
+
+func (v listInt64BetweenValidator) Description(ctx context.Context) string {
+	return "each " + v.element.Description(ctx)
+}
+
+func (v listInt64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v listInt64BetweenValidator) ValidateList(ctx context.Context,
+	req validator.ListRequest, resp *validator.ListResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for key, elem := range req.ConfigValue.Elements() {
+		value, ok := elem.(types.Int64)
+		if !ok {
+			continue
+		}
+		elemReq := validator.Int64Request{
+			Path:        req.Path.AtListIndex(key),
+			ConfigValue: value,
+		}
+		elemResp := validator.Int64Response{}
+		v.element.ValidateInt64(ctx, elemReq, &elemResp)
+		resp.Diagnostics.Append(elemResp.Diagnostics...)
+	}
+}
+
+// listInt64Between accepts lists of integers from min to max inclusive.
+func listInt64Between(min int64, max int64) validator.List {
+	return listInt64BetweenValidator{element: int64BetweenValidator{min: min, max: max}}
+}
+
+// float64BetweenValidator checks a number attribute is within bounds.
+type float64BetweenValidator struct {
+	min float64
+	max float64
+}
+
+func (v float64BetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.min, v.max)
+}
+
+func (v float64BetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64BetweenValidator) ValidateFloat64(ctx context.Context,
+	req validator.Float64Request, resp *validator.Float64Response) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueFloat64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %g", req.Path, v.Description(ctx), value))
+	}
+}
+
+// float64Between accepts numbers from min to max inclusive.
+func float64Between(min float64, max float64) validator.Float64 {
+	return float64BetweenValidator{min: min, max: max}
+}
+
+// timeFormatValidator checks a string attribute holds a time in layout.
+type timeFormatValidator struct {
+	layout  string
+	example string
+}
+
+func (v timeFormatValidator) Description(_ context.Context) string {
+	return "value must be a time formatted like " + v.example
+}
+
+func (v timeFormatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeFormatValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(v.layout, value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value))
+	}
+}
+
+// timeOfDay accepts 24 hour times such as 17:30.
+func timeOfDay() validator.String {
+	return timeFormatValidator{layout: timeOfDayLayout, example: "17:30"}
+}
+
+// dateTime accepts dates with time such as 2024-01-31T17:30.
+func dateTime() validator.String {
+	return timeFormatValidator{layout: dateTimeLayout, example: "2024-01-31T17:30"}
+}
+
+// bandwidthValidator checks a string attribute holds a bandwidth with
+// unit, see parseBandwidth.
+type bandwidthValidator struct{}
+
+func (v bandwidthValidator) Description(_ context.Context) string {
+	return "value must be a positive integer followed by kbps, Mbps or Gbps, e.g. 20Mbps"
+}
+
+func (v bandwidthValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v bandwidthValidator) ValidateString(ctx context.Context,
+	req validator.StringRequest, resp *validator.StringResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if _, err := parseBandwidth(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value))
+	}
+}
+
+// bandwidth accepts bandwidths such as 500kbps, 20Mbps or 1Gbps.
+func bandwidth() validator.String {
+	return bandwidthValidator{}
+}
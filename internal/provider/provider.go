@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"versa-networks.com/vclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,13 +22,30 @@ import (
 
 // versaDirectorProviderModel maps provider schema data to a Go type.
 type versaDirectorProviderModel struct {
-	Username          types.String `tfsdk:"username"`
-	Password          types.String `tfsdk:"password"`
-	Host              types.String `tfsdk:"host"`
-	Port              types.String `tfsdk:"port"`
-	OauthGrantType    types.String `tfsdk:"oauth_grant_type"`
-	OauthClientID     types.String `tfsdk:"oauth_client_id"`
-	OauthClientSecret types.String `tfsdk:"oauth_client_secret"`
+	Username          types.String  `tfsdk:"username"`
+	Password          types.String  `tfsdk:"password"`
+	Host              types.String  `tfsdk:"host"`
+	Port              types.String  `tfsdk:"port"`
+	OauthGrantType    types.String  `tfsdk:"oauth_grant_type"`
+	OauthClientID     types.String  `tfsdk:"oauth_client_id"`
+	OauthClientSecret types.String  `tfsdk:"oauth_client_secret"`
+	PageSize          types.Int64   `tfsdk:"page_size"`
+	PageConcurrency   types.Int64   `tfsdk:"page_concurrency"`
+	TokenCache        types.String  `tfsdk:"token_cache"`
+	Insecure          types.Bool    `tfsdk:"insecure"`
+	CACertPEM         types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile        types.String  `tfsdk:"ca_cert_file"`
+	ClientCert        types.String  `tfsdk:"client_cert"`
+	ClientKey         types.String  `tfsdk:"client_key"`
+	TLSServerName     types.String  `tfsdk:"tls_server_name"`
+	BaseURL           types.String  `tfsdk:"base_url"`
+	RequestTimeout    types.Int64   `tfsdk:"request_timeout"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxBackoff   types.Int64   `tfsdk:"retry_max_backoff"`
+	RateLimit         types.Float64 `tfsdk:"rate_limit"`
+	RateBurst         types.Int64   `tfsdk:"rate_burst"`
+	MaxInFlight       types.Int64   `tfsdk:"max_in_flight"`
+	MaxResponseSize   types.Int64   `tfsdk:"max_response_size"`
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,6 +57,17 @@ var (
 func (p *versaDirectorProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAddressesDataSource,
+		NewOrganizationsDataSource,
+		NewAppliancesDataSource,
+		NewAddressGroupDataSource,
+		NewServiceDataSource,
+		NewServiceGroupDataSource,
+		NewZonesDataSource,
+		NewURLFilteringProfileDataSource,
+		NewIPSProfileDataSource,
+		NewAntivirusProfileDataSource,
+		NewFileFilteringProfileDataSource,
+		NewApplicationsDataSource,
 	}
 }
 
@@ -81,11 +114,11 @@ func (p *versaDirectorProvider) Schema(_ context.Context,
 				Sensitive:   true,
 			},
 			"host": schema.StringAttribute{
-				Description: "IP Address for versadirector, May also be provided via VERSA_DIRECTOR_HOST environment variable.",
+				Description: "IP Address for versadirector, May also be provided via VERSA_DIRECTOR_HOST environment variable. Not needed when base_url is set.",
 				Optional:    true,
 			},
 			"port": schema.StringAttribute{
-				Description: "Port for versadirector, May also be provided via VERSA_DIRECTOR_PORT environment variable.",
+				Description: "Port for versadirector, May also be provided via VERSA_DIRECTOR_PORT environment variable. Not needed when base_url is set.",
 				Optional:    true,
 			},
 			"oauth_grant_type": schema.StringAttribute{
@@ -103,6 +136,75 @@ func (p *versaDirectorProvider) Schema(_ context.Context,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"page_size": schema.Int64Attribute{
+				Description: "Number of objects requested per page from list APIs such as organizations and appliances. Defaults to 50.",
+				Optional:    true,
+			},
+			"page_concurrency": schema.Int64Attribute{
+				Description: "Maximum number of pages fetched in parallel from list APIs. Defaults to 4.",
+				Optional:    true,
+			},
+			"token_cache": schema.StringAttribute{
+				Description: "Where OAUTH2 tokens are cached: memory (default), file for a per-user file under $XDG_CACHE_HOME readable only by owner, or none. May also be provided via VERSA_DIRECTOR_TOKEN_CACHE environment variable.",
+				Optional:    true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of versadirector API including scheme, host, port and optional path prefix, e.g. https://director.example.com:9182. Overrides host and port, May also be provided via VERSA_DIRECTOR_BASE_URL environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Time in seconds allowed for a single request to versadirector. Defaults to 60, 0 disables the limit.",
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of times a throttled or failed request is retried with exponential backoff. Defaults to 3, 0 disables retries.",
+				Optional:    true,
+			},
+			"retry_max_backoff": schema.Int64Attribute{
+				Description: "Maximum time in seconds to wait between retries, unless versadirector asks for longer with Retry-After. Defaults to 30.",
+				Optional:    true,
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to versadirector. Not limited by default.",
+				Optional:    true,
+			},
+			"rate_burst": schema.Int64Attribute{
+				Description: "Number of requests which may be sent at once before rate_limit applies. Defaults to 1.",
+				Optional:    true,
+			},
+			"max_in_flight": schema.Int64Attribute{
+				Description: "Maximum number of requests outstanding on versadirector at the same time. Not limited by default.",
+				Optional:    true,
+			},
+			"max_response_size": schema.Int64Attribute{
+				Description: "Maximum size in bytes of a response accepted from versadirector, larger responses fail with an error. Not limited by default.",
+				Optional:    true,
+			},
+			"insecure": schema.BoolAttribute{
+				Description: "Skip verification of versadirector TLS certificate. Not recommended outside lab setups, May also be provided via VERSA_DIRECTOR_INSECURE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA bundle used to verify versadirector certificate instead of system roots, May also be provided via VERSA_DIRECTOR_CA_CERT_PEM environment variable. Conflicts with ca_cert_file.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to PEM encoded CA bundle used to verify versadirector certificate instead of system roots, May also be provided via VERSA_DIRECTOR_CA_CERT_FILE environment variable. Conflicts with ca_cert_pem.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate, or path to it, for mutual TLS with versadirector, May also be provided via VERSA_DIRECTOR_CLIENT_CERT environment variable.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key, or path to it, for client_cert, May also be provided via VERSA_DIRECTOR_CLIENT_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "Server name used to verify versadirector certificate when host is an IP address or differs from certificate name, May also be provided via VERSA_DIRECTOR_TLS_SERVER_NAME environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -184,6 +286,87 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 		)
 	}
 
+	if !config.PageSize.IsNull() && !config.PageSize.IsUnknown() &&
+		config.PageSize.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_size"),
+			"Invalid versaDirector API PageSize",
+			"The provider cannot create the versaDirector API client as page_size must be greater than zero.",
+		)
+	}
+
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() &&
+		config.RequestTimeout.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Invalid versaDirector API RequestTimeout",
+			"The provider cannot create the versaDirector API client as request_timeout can't be negative.",
+		)
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() &&
+		config.MaxRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid versaDirector API MaxRetries",
+			"The provider cannot create the versaDirector API client as max_retries can't be negative.",
+		)
+	}
+
+	if !config.RetryMaxBackoff.IsNull() && !config.RetryMaxBackoff.IsUnknown() &&
+		config.RetryMaxBackoff.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_backoff"),
+			"Invalid versaDirector API RetryMaxBackoff",
+			"The provider cannot create the versaDirector API client as retry_max_backoff must be greater than zero.",
+		)
+	}
+
+	if !config.RateLimit.IsNull() && !config.RateLimit.IsUnknown() &&
+		config.RateLimit.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_limit"),
+			"Invalid versaDirector API RateLimit",
+			"The provider cannot create the versaDirector API client as rate_limit can't be negative.",
+		)
+	}
+
+	if !config.RateBurst.IsNull() && !config.RateBurst.IsUnknown() &&
+		config.RateBurst.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rate_burst"),
+			"Invalid versaDirector API RateBurst",
+			"The provider cannot create the versaDirector API client as rate_burst must be greater than zero.",
+		)
+	}
+
+	if !config.MaxInFlight.IsNull() && !config.MaxInFlight.IsUnknown() &&
+		config.MaxInFlight.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_in_flight"),
+			"Invalid versaDirector API MaxInFlight",
+			"The provider cannot create the versaDirector API client as max_in_flight can't be negative.",
+		)
+	}
+
+	if !config.MaxResponseSize.IsNull() && !config.MaxResponseSize.IsUnknown() &&
+		config.MaxResponseSize.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_response_size"),
+			"Invalid versaDirector API MaxResponseSize",
+			"The provider cannot create the versaDirector API client as max_response_size can't be negative.",
+		)
+	}
+
+	if !config.PageConcurrency.IsNull() && !config.PageConcurrency.IsUnknown() &&
+		config.PageConcurrency.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_concurrency"),
+			"Invalid versaDirector API PageConcurrency",
+			"The provider cannot create the versaDirector API client as page_concurrency must be greater than zero.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -198,6 +381,8 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 	oauthGrantType := os.Getenv("VERSA_DIRECTOR_OAUTH_GRANT_TYPE")
 	oauthClientId := os.Getenv("VERSA_DIRECTOR_OAUTH_CLIENT_ID")
 	oauthClientSecret := os.Getenv("VERSA_DIRECTOR_OAUTH_CLIENT_SECRET")
+	tokenCacheName := os.Getenv("VERSA_DIRECTOR_TOKEN_CACHE")
+	baseURL := os.Getenv("VERSA_DIRECTOR_BASE_URL")
 
 	if !config.Username.IsNull() {
 		username = config.Username.ValueString()
@@ -227,10 +412,18 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 		oauthClientSecret = config.OauthClientSecret.ValueString()
 	}
 
+	if !config.TokenCache.IsNull() {
+		tokenCacheName = config.TokenCache.ValueString()
+	}
+
+	if !config.BaseURL.IsNull() {
+		baseURL = config.BaseURL.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if host == "" {
+	if host == "" && baseURL == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing HashiCups API Host",
@@ -260,7 +453,7 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 		)
 	}
 
-	if port == "" {
+	if port == "" && baseURL == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("port"),
 			"Missing versaDirector API Port",
@@ -300,12 +493,55 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 		)
 	}
 
+	tlsOptions := providerTLSOptions(config, &resp.Diagnostics)
+
+	tokenCache, err := vclient.TokenCacheByName(tokenCacheName)
+	if errors.Is(err, vclient.ErrUnknownTokenCache) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_cache"),
+			"Invalid versaDirector API TokenCache",
+			"The provider cannot create the versaDirector API client as token_cache must be one of memory, file or none, got: "+tokenCacheName+". "+
+				"Set the token_cache value in the configuration or use the VERSA_DIRECTOR_TOKEN_CACHE environment variable.",
+		)
+	} else if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_cache"),
+			"Unable to Create versaDirector API TokenCache",
+			"The provider cannot create the versaDirector API client as "+tokenCacheName+" token cache failed: "+err.Error()+". "+
+				"Select another token_cache value in the configuration or use the VERSA_DIRECTOR_TOKEN_CACHE environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create a new HashiCups client using the configuration values
-	client, err := vclient.NewClient(&host, &username, &password, &port, &oauthClientId, &oauthClientSecret, &oauthGrantType)
+	clientOptions := []vclient.ClientOption{
+		vclient.WithTokenCache(tokenCache),
+		vclient.WithTLS(tlsOptions),
+	}
+	if baseURL != "" {
+		clientOptions = append(clientOptions, vclient.WithBaseURL(baseURL))
+	}
+	if !config.RequestTimeout.IsNull() {
+		clientOptions = append(clientOptions,
+			vclient.WithRequestTimeout(time.Duration(config.RequestTimeout.ValueInt64())*time.Second))
+	}
+	retryPolicy := vclient.DefaultRetryPolicy()
+	if !config.MaxRetries.IsNull() {
+		retryPolicy.MaxAttempts = int(config.MaxRetries.ValueInt64()) + 1
+	}
+	if !config.RetryMaxBackoff.IsNull() {
+		retryPolicy.MaxBackoff = time.Duration(config.RetryMaxBackoff.ValueInt64()) * time.Second
+	}
+	clientOptions = append(clientOptions,
+		vclient.WithRetryPolicy(retryPolicy),
+		vclient.WithRateLimit(config.RateLimit.ValueFloat64(), int(config.RateBurst.ValueInt64())),
+		vclient.WithMaxInFlight(int(config.MaxInFlight.ValueInt64())),
+		vclient.WithMaxResponseSize(config.MaxResponseSize.ValueInt64()))
+	client, err := vclient.NewClient(&host, &username, &password, &port, &oauthClientId, &oauthClientSecret, &oauthGrantType,
+		clientOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create versaDirector API Client",
@@ -316,16 +552,125 @@ func (p *versaDirectorProvider) Configure(ctx context.Context,
 		return
 	}
 
+	client.Pagination = vclient.PaginationConfig{
+		PageSize:    int(config.PageSize.ValueInt64()),
+		Concurrency: int(config.PageConcurrency.ValueInt64()),
+	}
+
 	// Make the HashiCups client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// providerTLSOptions collects TLS settings from configuration and
+// environment, reading certificate files where paths are given.
+func providerTLSOptions(config versaDirectorProviderModel,
+	diags *diag.Diagnostics) vclient.TLSOptions {
+
+	var options vclient.TLSOptions
+
+	insecure := os.Getenv("VERSA_DIRECTOR_INSECURE")
+	caCertPEM := os.Getenv("VERSA_DIRECTOR_CA_CERT_PEM")
+	caCertFile := os.Getenv("VERSA_DIRECTOR_CA_CERT_FILE")
+	clientCert := os.Getenv("VERSA_DIRECTOR_CLIENT_CERT")
+	clientKey := os.Getenv("VERSA_DIRECTOR_CLIENT_KEY")
+	options.ServerName = os.Getenv("VERSA_DIRECTOR_TLS_SERVER_NAME")
+
+	if len(insecure) > 0 {
+		value, err := strconv.ParseBool(insecure)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure"),
+				"Invalid versaDirector API Insecure",
+				"The VERSA_DIRECTOR_INSECURE environment variable must be true or false, got: "+insecure+".",
+			)
+		}
+		options.Insecure = value
+	}
+	if !config.Insecure.IsNull() {
+		options.Insecure = config.Insecure.ValueBool()
+	}
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+	if !config.ClientCert.IsNull() {
+		clientCert = config.ClientCert.ValueString()
+	}
+	if !config.ClientKey.IsNull() {
+		clientKey = config.ClientKey.ValueString()
+	}
+	if !config.TLSServerName.IsNull() {
+		options.ServerName = config.TLSServerName.ValueString()
+	}
+
+	if len(caCertPEM) > 0 && len(caCertFile) > 0 {
+		diags.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Conflicting versaDirector API CA Certificate",
+			"Only one of ca_cert_pem and ca_cert_file can be set.",
+		)
+	}
+	options.CACertPEM = []byte(caCertPEM)
+	if len(caCertFile) > 0 {
+		options.CACertPEM = readPEMFile(path.Root("ca_cert_file"), caCertFile, diags)
+	}
+	options.ClientCertPEM = pemOrFile(path.Root("client_cert"), clientCert, diags)
+	options.ClientKeyPEM = pemOrFile(path.Root("client_key"), clientKey, diags)
+
+	return options
+}
+
+// pemOrFile returns value when it holds PEM data, otherwise treats it as
+// a path and returns the file content.
+func pemOrFile(attrPath path.Path, value string, diags *diag.Diagnostics) []byte {
+	if len(value) == 0 || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value)
+	}
+	return readPEMFile(attrPath, value, diags)
+}
+
+func readPEMFile(attrPath path.Path, fileName string, diags *diag.Diagnostics) []byte {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath,
+			"Unable to Read versaDirector API Certificate",
+			"The provider cannot read "+fileName+": "+err.Error(),
+		)
+	}
+	return data
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *versaDirectorProvider) Resources(_ context.Context) []func() resource.Resource {
 	log.Printf("Resources called .....\n")
 	return []func() resource.Resource{
 		NewAddressResource,
+		NewAddressObjectResource,
+		NewAddressGroupResource,
+		NewServiceResource,
+		NewServiceGroupResource,
+		NewScheduleResource,
+		NewZoneResource,
+		NewSecurityPolicyResource,
+		NewSecurityRuleResource,
+		NewURLFilteringProfileResource,
+		NewIPSProfileResource,
+		NewAntivirusProfileResource,
+		NewFileFilteringProfileResource,
+		NewCustomApplicationResource,
+		NewCustomURLCategoryResource,
+		NewSLAProfileResource,
+		NewForwardingProfileResource,
+		NewSDWANRuleResource,
+		NewQoSProfileResource,
+		NewCoSMappingResource,
+		NewAppQoSRuleResource,
+		NewNATPoolResource,
+		NewNATRuleResource,
 	}
 }
@@ -1,8 +1,14 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"versa-networks.com/vclient"
 )
 
 const (
@@ -17,6 +23,7 @@ provider "versadirector" {
 	oauth_grant_type    = "password"
 	oauth_client_id     = "CA736092A7221051EA93B4447A259744"
 	oauth_client_secret = "6bafb4e78e909775a377eedf022610a6"
+	insecure            = true
 }
 data "versadirector_addresses" "test" {
 	device_name       = "Branch-1"
@@ -34,3 +41,41 @@ var (
 		"versadirector": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccClient returns a client of the director of providerConfig, to
+// check configuration not visible in the Terraform state.
+func testAccClient(t *testing.T) *vclient.Client {
+	host, port := "10.40.73.242", "9182"
+	username, password := "Administrator", "Versa123#"
+	clientID, clientSecret := "CA736092A7221051EA93B4447A259744", "6bafb4e78e909775a377eedf022610a6"
+	grantType := "password"
+	client, err := vclient.NewClient(&host, &username, &password, &port, &clientID, &clientSecret,
+		&grantType, vclient.WithTLS(vclient.TLSOptions{Insecure: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// testAccCheckRuleOrder checks the rule names returned by list include
+// names in the given order.
+func testAccCheckRuleOrder(t *testing.T, list func(context.Context, *vclient.Client) ([]string, error),
+	names ...string) func(*terraform.State) error {
+
+	return func(*terraform.State) error {
+		rules, err := list(context.Background(), testAccClient(t))
+		if err != nil {
+			return err
+		}
+		next := 0
+		for _, val := range rules {
+			if next < len(names) && val == names[next] {
+				next++
+			}
+		}
+		if next < len(names) {
+			return fmt.Errorf("rules %v don't include %v in order", rules, names)
+		}
+		return nil
+	}
+}
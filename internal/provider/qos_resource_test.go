@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQoSResources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_qos_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-qos-1"
+  peak_rate         = "20Mbps"
+  forwarding_class  = "fc8"
+}
+
+resource "versadirector_app_qos_policy_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = "Default-Policy"
+  name              = "versa-networks-rule-1"
+  dscp              = [34]
+  qos_profile       = versadirector_qos_profile.test.name
+}
+
+resource "versadirector_class_of_service_mapping" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-classifier-1"
+  mappings = [
+    {
+      dscp             = [46]
+      forwarding_class = "fc1"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_qos_profile.test", "peak_rate", "20Mbps"),
+					resource.TestCheckResourceAttr("versadirector_app_qos_policy_rule.test", "id",
+						"Branch-1/Customer-1/Default-Policy/versa-networks-rule-1"),
+					resource.TestCheckResourceAttr("versadirector_class_of_service_mapping.test", "mappings.0.dscp.0", "46"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_class_of_service_mapping.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccQoSResourcesInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_qos_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-qos-1"
+  peak_rate         = "20MB"
+}
+`,
+				ExpectError: regexp.MustCompile(`kbps, Mbps or Gbps`),
+			},
+			{
+				Config: providerConfig + `
+resource "versadirector_class_of_service_mapping" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-classifier-1"
+  mappings = [
+    {
+      dscp             = [46]
+      forwarding_class = "fc1"
+    },
+    {
+      dscp             = [46]
+      forwarding_class = "fc2"
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`already mapped`),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_schedule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-schedule-1"
+  daily = [
+    {
+      start = "08:00"
+      end   = "17:30"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_schedule.test", "id", "Branch-1/Customer-1/versa-networks-schedule-1"),
+					resource.TestCheckResourceAttr("versadirector_schedule.test", "daily.0.end", "17:30"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_schedule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-schedule-1"
+  weekly = [
+    {
+      day   = "saturday"
+      start = "10:00"
+      end   = "14:00"
+    },
+    {
+      day   = "friday"
+      start = "22:00"
+      end   = "06:00"
+    }
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_schedule.test", "weekly.#", "2"),
+					resource.TestCheckNoResourceAttr("versadirector_schedule.test", "daily.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccScheduleResourceInvalidTime(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_schedule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-schedule-1"
+  daily = [
+    {
+      start = "8am"
+      end   = "17:30"
+    }
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestAccScheduleResourceEmptyWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_schedule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-schedule-1"
+  weekly = [
+    {
+      day   = "sunday"
+      start = "10:00"
+      end   = "10:00"
+    }
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile("Weekly window on sunday end 10:00 must differ"),
+			},
+		},
+	})
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSDWANResources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_sla_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-sla-1"
+  latency           = 150
+  loss              = 1
+}
+
+resource "versadirector_forwarding_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-fp-1"
+  circuit_priorities = [
+    {
+      priority = 1
+      circuits = ["MPLS"]
+    },
+  ]
+  sla_profile = versadirector_sla_profile.test.name
+}
+
+resource "versadirector_sdwan_policy_rule" "test" {
+  device_name        = "Branch-1"
+  organization_name  = "Customer-1"
+  policy_name        = "Default-Policy"
+  name               = "versa-networks-rule-1"
+  dscp               = [46]
+  forwarding_profile = versadirector_forwarding_profile.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_forwarding_profile.test", "load_balancing", "per-flow"),
+					resource.TestCheckResourceAttr("versadirector_forwarding_profile.test", "replication", "false"),
+					resource.TestCheckResourceAttr("versadirector_sdwan_policy_rule.test", "id",
+						"Branch-1/Customer-1/Default-Policy/versa-networks-rule-1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_sdwan_policy_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "position", "relative_to"},
+			},
+			{
+				ResourceName:            "versadirector_forwarding_profile.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSDWANResourcesInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_sdwan_policy_rule" "test" {
+  device_name        = "Branch-1"
+  organization_name  = "Customer-1"
+  policy_name        = "Default-Policy"
+  name               = "versa-networks-rule-1"
+  dscp               = [64]
+  forwarding_profile = "versa-networks-fp-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+			{
+				Config: providerConfig + `
+resource "versadirector_forwarding_profile" "test" {
+  device_name        = "Branch-1"
+  organization_name  = "Customer-1"
+  name               = "versa-networks-fp-1"
+  replication_factor = 2
+}
+`,
+				ExpectError: regexp.MustCompile(`replication_factor can only be set`),
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecurityProfileResources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_url_filtering_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-urlf-1"
+  category_actions = [
+    {
+      name           = "block-malware"
+      action         = "block"
+      url_categories = ["malware_sites"]
+    },
+  ]
+  deny_list = ["badsite.example.org"]
+}
+
+resource "versadirector_ips_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-ips-1"
+  rules = [
+    {
+      name       = "drop-critical"
+      severities = ["critical"]
+      action     = "drop-session"
+    },
+  ]
+}
+
+resource "versadirector_antivirus_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-av-1"
+  action            = "block"
+  protocols         = ["http"]
+}
+
+resource "versadirector_file_filtering_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-ff-1"
+  rules = [
+    {
+      name       = "block-exe"
+      file_types = ["exe"]
+      action     = "block"
+    },
+  ]
+}
+
+data "versadirector_url_filtering_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = versadirector_url_filtering_profile.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_url_filtering_profile.test", "default_action", "allow"),
+					resource.TestCheckResourceAttr("versadirector_antivirus_profile.test", "direction", "both"),
+					resource.TestCheckResourceAttr("versadirector_file_filtering_profile.test", "default_action", "allow"),
+					resource.TestCheckResourceAttr("data.versadirector_url_filtering_profile.test", "category_actions.0.action", "block"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_ips_profile.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecurityProfileResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_antivirus_profile" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-av-1"
+  action            = "block"
+  protocols         = ["gopher"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
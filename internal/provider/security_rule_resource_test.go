@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"versa-networks.com/vclient"
+)
+
+func TestAccSecurityRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_security_policy" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-policy-1"
+}
+
+resource "versadirector_security_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = versadirector_security_policy.test.name
+  name              = "versa-networks-rule-1"
+  source_zones      = ["trust"]
+  services          = ["https"]
+  action            = "allow"
+  position          = "top"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_security_rule.test", "id",
+						"Branch-1/Customer-1/versa-networks-policy-1/versa-networks-rule-1"),
+					resource.TestCheckResourceAttr("versadirector_security_rule.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("versadirector_security_rule.test", "source_zones.0", "trust"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_security_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "position", "relative_to"},
+			},
+			{
+				ResourceName:            "versadirector_security_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Rule ordering testing
+			{
+				Config: providerConfig + `
+resource "versadirector_security_policy" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-policy-1"
+}
+
+resource "versadirector_security_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = versadirector_security_policy.test.name
+  name              = "versa-networks-rule-1"
+  source_zones      = ["trust"]
+  services          = ["https"]
+  action            = "allow"
+  position          = "top"
+}
+
+resource "versadirector_security_rule" "before" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = versadirector_security_policy.test.name
+  name              = "versa-networks-rule-0"
+  action            = "deny"
+  position          = "before"
+  relative_to       = versadirector_security_rule.test.name
+}
+
+resource "versadirector_security_rule" "after" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = versadirector_security_policy.test.name
+  name              = "versa-networks-rule-2"
+  action            = "reject"
+  position          = "after"
+  relative_to       = versadirector_security_rule.test.name
+}
+`,
+				Check: testAccCheckRuleOrder(t, func(ctx context.Context, client *vclient.Client) ([]string, error) {
+					rules, err := client.GetDeviceOrganizationSecurityRules(ctx, "Branch-1", "Customer-1",
+						"versa-networks-policy-1")
+					var names []string
+					for _, val := range rules {
+						names = append(names, val.Name)
+					}
+					return names, err
+				}, "versa-networks-rule-0", "versa-networks-rule-1", "versa-networks-rule-2"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecurityRuleResourcePositionAdded(t *testing.T) {
+	config := func(position string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "versadirector_security_policy" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-policy-1"
+}
+
+resource "versadirector_security_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = versadirector_security_policy.test.name
+  name              = "versa-networks-rule-1"
+  action            = "allow"
+  %v
+}
+`, position)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+			},
+			// Setting a position on an existing rule is only recorded
+			{
+				Config: config(`position = "top"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("versadirector_security_rule.test",
+							plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("versadirector_security_rule.test", "position", "top"),
+			},
+			// Changing it recreates the rule
+			{
+				Config: config(`position = "bottom"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("versadirector_security_rule.test",
+							plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecurityRuleResourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_security_rule" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  policy_name       = "versa-networks-policy-1"
+  name              = "versa-networks-rule-1"
+  action            = "deny"
+  ips_profile       = "default-ips"
+  position          = "after"
+}
+`,
+				ExpectError: regexp.MustCompile(`relative_to must be set`),
+			},
+		},
+	})
+}
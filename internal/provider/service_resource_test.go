@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_service" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-service-1"
+  protocol          = "tcp"
+  destination_port  = "8443"
+}
+
+resource "versadirector_service_group" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-service-group-1"
+  services          = [versadirector_service.test.name]
+}
+
+data "versadirector_service" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = versadirector_service.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_service.test", "id", "Branch-1/Customer-1/versa-networks-service-1"),
+					resource.TestCheckResourceAttr("data.versadirector_service.test", "destination_port", "8443"),
+					resource.TestCheckResourceAttr("versadirector_service_group.test", "services.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_service.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_service" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-service-1"
+  protocol          = "udp"
+  destination_port  = "5000-5010"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_service.test", "protocol", "udp"),
+					resource.TestCheckResourceAttr("versadirector_service.test", "destination_port", "5000-5010"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccServiceResourceInvalidPorts(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "versadirector_service" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-service-1"
+  protocol          = "icmp"
+  destination_port  = "80"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
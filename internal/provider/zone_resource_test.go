@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "versadirector_zone" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = "versa-networks-zone-1"
+  networks          = ["LAN1"]
+}
+
+data "versadirector_zones" "test" {
+  device_name       = "Branch-1"
+  organization_name = "Customer-1"
+  name              = versadirector_zone.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("versadirector_zone.test", "id", "Branch-1/Customer-1/versa-networks-zone-1"),
+					resource.TestCheckResourceAttr("data.versadirector_zones.test", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.versadirector_zones.test", "zones.0.networks.0", "LAN1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "versadirector_zone.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
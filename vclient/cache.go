@@ -0,0 +1,157 @@
+package vclient
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+/* directory under user cache directory holding cached tokens */
+const vTokenCacheDirName = "terraform-provider-versadirector"
+
+// TokenCache stores OAUTH2 token data between client instances so a new
+// token isn't requested from director on every Terraform run. Key
+// identifies director, user and OAUTH2 client; data is opaque to cache.
+type TokenCache interface {
+	Get(key string) ([]byte, bool)
+	Put(key string, data []byte) error
+}
+
+/* in-memory cache shared by all clients of the provider process */
+var vMemoryTokenCache = &memoryTokenCache{}
+
+type memoryTokenCache struct {
+	entries sync.Map
+}
+
+// NewMemoryTokenCache returns the process wide in-memory token cache.
+// Tokens are kept only for the lifetime of the provider process.
+func NewMemoryTokenCache() TokenCache {
+	return vMemoryTokenCache
+}
+
+func (m *memoryTokenCache) Get(key string) ([]byte, bool) {
+	data, ok := m.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+	return data.([]byte), true
+}
+
+func (m *memoryTokenCache) Put(key string, data []byte) error {
+	m.entries.Store(key, append([]byte(nil), data...))
+	return nil
+}
+
+type fileTokenCache struct {
+	dir string
+}
+
+// NewFileTokenCache returns a cache keeping one file per director, user
+// and OAUTH2 client in dir. Empty dir selects a per-user directory under
+// $XDG_CACHE_HOME (or platform equivalent). Files are readable by owner
+// only.
+func NewFileTokenCache(dir string) (TokenCache, error) {
+	if len(dir) == 0 {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(cacheDir, vTokenCacheDirName)
+	}
+	return &fileTokenCache{dir: dir}, nil
+}
+
+func (f *fileTokenCache) path(key string) string {
+	return filepath.Join(f.dir, key+".json")
+}
+
+func (f *fileTokenCache) Get(key string) ([]byte, bool) {
+	fileName := f.path(key)
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return nil, false
+	}
+	/* ignore files others could have read or planted */
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, false
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+func (f *fileTokenCache) Put(key string, data []byte) error {
+	if err := os.MkdirAll(f.dir, 0700); err != nil {
+		return err
+	}
+
+	/* write to temporary file and rename so readers never see partial data */
+	tmpFile, err := os.CreateTemp(f.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := tmpFile.Name()
+	defer os.Remove(tmpName)
+
+	if err := tmpFile.Chmod(0600); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, f.path(key))
+}
+
+type noTokenCache struct{}
+
+// NewNoTokenCache returns a cache which never keeps tokens.
+func NewNoTokenCache() TokenCache {
+	return noTokenCache{}
+}
+
+func (noTokenCache) Get(string) ([]byte, bool) {
+	return nil, false
+}
+
+func (noTokenCache) Put(string, []byte) error {
+	return nil
+}
+
+/*
+ * Get cache key for token of client. Credentials are hashed so key can be
+ * used as file name and doesn't expose user or client id.
+ */
+func vTokenCacheKey(config *vOauthConfig, host string) string {
+	sum := sha256.Sum256([]byte(host + "\x00" + config.UserName + "\x00" + config.ClientID))
+	return hex.EncodeToString(sum[:])
+}
+
+// ErrUnknownTokenCache is returned by TokenCacheByName for names other
+// than "memory", "file" and "none".
+var ErrUnknownTokenCache = errors.New("unknown token cache")
+
+// TokenCacheByName returns token cache selected by name: "memory",
+// "file" or "none".
+func TokenCacheByName(name string) (TokenCache, error) {
+	switch name {
+	case "", "memory":
+		return NewMemoryTokenCache(), nil
+	case "file":
+		return NewFileTokenCache("")
+	case "none":
+		return NewNoTokenCache(), nil
+	}
+	return nil, fmt.Errorf("%w %v", ErrUnknownTokenCache, name)
+}
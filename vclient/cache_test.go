@@ -0,0 +1,26 @@
+package vclient
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTokenCacheByName(t *testing.T) {
+	for _, name := range []string{"", "memory", "none"} {
+		if _, err := TokenCacheByName(name); err != nil {
+			t.Errorf("token cache %q: %v", name, err)
+		}
+	}
+
+	if _, err := TokenCacheByName("disk"); !errors.Is(err, ErrUnknownTokenCache) {
+		t.Errorf("got error %v, want ErrUnknownTokenCache", err)
+	}
+
+	/* user cache directory can't be found without HOME */
+	t.Setenv("XDG_CACHE_HOME", "")
+	t.Setenv("HOME", "")
+	_, err := TokenCacheByName("file")
+	if err == nil || errors.Is(err, ErrUnknownTokenCache) {
+		t.Errorf("got error %v, want user cache directory error", err)
+	}
+}
@@ -3,17 +3,16 @@ package vclient
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -21,8 +20,6 @@ import (
 
 /* path needs to be appended along with server-ip and port to get token */
 const (
-	vOauthConfigFile      = "../../vOauth2Config.json"
-	vOauthTokenFile       = "../../vOauth2Token.json"
 	vOauthServerTokenPath = "auth/token"
 )
 
@@ -31,11 +28,11 @@ const (
  * token used for subsequent http transactions.
  */
 type vOauthServerToken struct {
-	AccessToken  string `json:"access_token"`
-	IssuedAt     string `json:"issued_at,omitempty"`
-	ExpiresIn    string `json:"expires_in"`
-	TokenType    string `json:"token_type,omitempty"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken  string      `json:"access_token"`
+	IssuedAt     json.Number `json:"issued_at,omitempty"`
+	ExpiresIn    json.Number `json:"expires_in,omitempty"`
+	TokenType    string      `json:"token_type,omitempty"`
+	RefreshToken string      `json:"refresh_token"`
 	User         struct {
 		Name            string   `json:"name"`
 		IsExternalUser  bool     `json:"is_external_user,omitempty"`
@@ -66,7 +63,71 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Config     vOauthConfig
-	Token      vOauthServerToken
+	Pagination PaginationConfig
+	tokens     *vTokenSource
+	transport  *http.Transport
+	baseURL    *url.URL
+	timeout    time.Duration
+	retry      RetryPolicy
+	limiter    *vRateLimiter
+	inFlight   chan struct{}
+	maxBody    int64
+	apps       vPredefinedApplications
+}
+
+/* default time allowed for a single request to director */
+const vDefaultRequestTimeout = 60 * time.Second
+
+// ClientOption configures optional behaviour of Client in NewClient.
+type ClientOption func(*Client) error
+
+// WithBaseURL sets scheme, host, port and optional path prefix of
+// director API, e.g. https://director.example.com:9182/prefix. By default
+// https://<host>:<port>/ is used.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		parsed, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		if parsed.Scheme != "https" && parsed.Scheme != "http" {
+			return errors.New("base URL scheme must be https or http: " + baseURL)
+		}
+		if len(parsed.Host) == 0 {
+			return errors.New("base URL doesn't include host: " + baseURL)
+		}
+		parsed.RawQuery = ""
+		parsed.Fragment = ""
+		c.baseURL = parsed
+		return nil
+	}
+}
+
+// WithRequestTimeout sets time allowed for a single request to director,
+// on top of any deadline of the request context. Zero disables it.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithMaxResponseSize limits size in bytes of response body accepted from
+// director. Zero, the default, accepts responses of any size.
+func WithMaxResponseSize(maxBytes int64) ClientOption {
+	return func(c *Client) error {
+		c.maxBody = maxBytes
+		return nil
+	}
+}
+
+// WithTokenCache selects where OAUTH2 tokens are cached between clients.
+// In-memory cache is used when this option isn't given.
+func WithTokenCache(cache TokenCache) ClientOption {
+	return func(c *Client) error {
+		c.tokens.cache = cache
+		return nil
+	}
 }
 
 /*
@@ -74,11 +135,11 @@ type Client struct {
  */
 func vOauthTokenDisplay(tokenData vOauthServerToken) {
 	log.Printf("----- OAUTH Token Data -----\n")
-	log.Printf("Access Token         : %v\n", tokenData.AccessToken)
+	log.Printf("Access Token         : %v\n", vOauthTokenRedact(tokenData.AccessToken))
 	log.Printf("Issued At            : %v\n", tokenData.IssuedAt)
 	log.Printf("Expires In           : %v\n", tokenData.ExpiresIn)
 	log.Printf("Token Type           : %v\n", tokenData.TokenType)
-	log.Printf("Refresh Token        : %v\n", tokenData.RefreshToken)
+	log.Printf("Refresh Token        : %v\n", vOauthTokenRedact(tokenData.RefreshToken))
 	log.Printf("  User               : %v\n", tokenData.User.Name)
 	log.Printf("  External User      : %v\n", tokenData.User.IsExternalUser)
 	log.Printf("  Two Factor Enabled : %v\n", tokenData.User.EnableTwoFactor)
@@ -89,137 +150,72 @@ func vOauthTokenDisplay(tokenData vOauthServerToken) {
 }
 
 /*
- * API to get OAUTH2 token from server. It sends POST request to server with
- * configuration data read from json file and/or from environment variables.
- * The token received from server is copied to golbal data structure to be
- * used for subsequent api calls with server.
+ * Hide token value in logs, only its length is shown.
  */
-func vOauthGetToken(client *Client) error {
-
-	config := &client.Config
-
-	oauthParams := map[string]string{
-		"client_id":     config.ClientID,
-		"client_secret": config.ClientSecret,
-		"grant_type":    config.GrantType,
-		"username":      config.UserName,
-		"password":      config.Password,
-	}
-
-	log.Printf("Get OAUTH tone for versadirector for %v\n", oauthParams)
-	if requestBody, err := json.Marshal(oauthParams); err != nil {
-		log.Printf("Unable to marshal oauth-parameters %v\n", err)
-	} else {
-		oauthServerUrl := "https://" +
-			config.ServerIP + ":" +
-			strconv.Itoa(config.ServerPort) + "/" +
-			vOauthServerTokenPath
-
-		tlsConfig := &tls.Config{InsecureSkipVerify: true}
-		tlsTransport := &http.Transport{TLSClientConfig: tlsConfig}
-		httpTransport := &http.Client{Transport: tlsTransport}
-		resp, err := httpTransport.Post(oauthServerUrl,
-			"application/json", bytes.NewBuffer(requestBody))
-		if err != nil {
-			log.Printf("Unable to send POST request to get token %v\n", err)
-			return err
-		}
-		defer resp.Body.Close()
-
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			log.Printf("Unable to read response from OAUTH server for token %v\n", err)
-			return err
-		}
-
-		var tokenData vOauthServerToken
-		if err := json.Unmarshal([]byte(body), &tokenData); err != nil {
-			log.Printf("Unable to unmarshal token response %v\n", err)
-			return err
-		}
-		client.Token = tokenData
-
-		vOauthTokenDisplay(tokenData)
-
-		if err := ioutil.WriteFile(vOauthTokenFile, body, 0777); err != nil {
-			log.Printf("Failed to write token data to file\n")
-		}
-	}
-
-	return nil
-}
-
-/*
- * Read token data from file. This file is created  after getting token from
- * director and same will be used until it is expired.
- */
-func vOauthReadToken(fileName string, client *Client) error {
-
-	var tokenData vOauthServerToken
-	if len(fileName) > 0 {
-		if fd, err := os.Open(fileName); err != nil {
-			log.Printf("Unable to open config file %v, error %v\n", fileName, err)
-			return err
-		} else {
-			defer fd.Close()
-			if fileData, err := ioutil.ReadAll(fd); err != nil {
-				log.Printf("Unable to read data from file %v fd %v error %v\n",
-					fileName, fd, err)
-				return err
-			} else {
-				if err := json.Unmarshal([]byte(fileData), &tokenData); err != nil {
-					log.Printf("JSON unmarshal failed for file %v error %v\n",
-						fileName, err)
-					return err
-				}
-			}
-		}
-	}
-
-	/* check validity of token */
-	if tokenData.ExpiresIn != "-1" {
-		log.Printf("Validate expiration date %v, get new token if expired\n",
-			tokenData.ExpiresIn)
-		return errors.New("Token expired, Get new one")
+func vOauthTokenRedact(token string) string {
+	if len(token) == 0 {
+		return ""
 	}
-
-	client.Token = tokenData
-
-	log.Printf("Read AUTH2 token from file %v\n", fileName)
-	vOauthTokenDisplay(tokenData)
-
-	return nil
+	return "<redacted " + strconv.Itoa(len(token)) + " bytes>"
 }
 
 // NewClient -
 func NewClient(host, username, password, port, oauthClientId,
-	oauthClientSecret, oauthGrantType *string) (*Client, error) {
+	oauthClientSecret, oauthGrantType *string, opts ...ClientOption) (*Client, error) {
 
+	defaultTLS, _ := TLSOptions{}.tlsConfig()
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		transport: vHttpTransport(defaultTLS),
+		timeout:   vDefaultRequestTimeout,
+		retry:     DefaultRetryPolicy(),
+	}
+	c.tokens = &vTokenSource{client: &c, cache: NewMemoryTokenCache()}
+	config := &c.Config
+	if host != nil {
+		config.ServerIP = *host
+	}
+	if port != nil {
+		config.ServerPort, _ = strconv.Atoi(*port)
+	}
+	if len(config.ServerIP) > 0 {
+		c.baseURL = &url.URL{
+			Scheme: "https",
+			Host:   net.JoinHostPort(config.ServerIP, strconv.Itoa(config.ServerPort)),
+			Path:   "/",
+		}
+	}
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+	/* timeout comes from request context, see vHttpSendReq */
+	c.HTTPClient = &http.Client{Transport: c.transport}
+	if c.baseURL != nil {
+		c.HostURL = c.baseURL.String()
 	}
 
 	// If username or password not provided, return empty client
 	if username == nil || password == nil {
 		return &c, nil
 	}
+	if c.baseURL == nil {
+		return nil, errors.New("versadirector host or base URL isn't configured")
+	}
 
-	log.Printf("Create new client for host %v:%v user %v client-id %v client-secret %v\n",
-		*host, *port, *username, *oauthClientId, *oauthClientSecret)
-	config := &c.Config
-	config.ServerIP = *host
-	config.ServerPort, _ = strconv.Atoi(*port)
+	log.Printf("Create new client for %v user %v client-id %v\n",
+		c.HostURL, *username, *oauthClientId)
 	config.UserName = *username
 	config.Password = *password
 	config.ClientID = *oauthClientId
 	config.ClientSecret = *oauthClientSecret
 	config.GrantType = *oauthGrantType
 
-	if err := vOauthReadToken(vOauthTokenFile, &c); err != nil {
-		/* get auth token from server */
-		if err := vOauthGetToken(&c); err != nil {
-			log.Fatal("Authentication token isn't available, aborting")
-		}
+	/* use cached token if still valid, otherwise get one from server */
+	c.tokens.load()
+	if _, err := c.tokens.AccessToken(context.Background()); err != nil {
+		log.Printf("Authentication token isn't available %v\n", err)
+		return nil, err
 	}
 
 	return &c, nil
@@ -239,204 +235,220 @@ func vDisplayResponse(body []byte) {
 }
 
 /*
- * Common utility function to create http client and url string
- * needed form http requests.
+ * Build url of director API from base url and path segments. Segments may
+ * contain '/' separated paths and are used as given, so device, org and
+ * object names must be escaped with vPathSegment first.
  */
-func vHttpClient(host string, port int, urlPath string) (*http.Client,
-	string, error) {
-
-	/* disable certificate validation */
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	client := &http.Client{
-		Timeout: time.Second * 10,
-	}
-	var httpUrl string
-	if len(urlPath) > 0 {
-		httpUrl = "https://" + host + ":" + strconv.Itoa(port) + "/" + urlPath
+func (c *Client) vApiURL(segments ...string) string {
+	if c.baseURL == nil {
+		return ""
 	}
+	return c.baseURL.JoinPath(segments...).String()
+}
 
-	return client, httpUrl, nil
+/*
+ * Escape name as a single path segment, so names such as "a/b", "50%" or
+ * ".." address the object of that name and nothing else.
+ */
+func vPathSegment(name string) string {
+	if name == "." || name == ".." {
+		return strings.ReplaceAll(name, ".", "%2E")
+	}
+	return url.PathEscape(name)
 }
 
-func (c *Client) vHttpHandleGetReq(ctx context.Context,
-	client *http.Client,
-	apiUrl string,
-	urlData url.Values) ([]byte, error) {
+/*
+ * Send a single http request to director with access token from token
+ * source. Request is bound to ctx and limited by client request timeout.
+ * Any status not in okStatus is returned as APIError. If out is given the
+ * response is decoded into it as it is read, otherwise response body is
+ * returned. Access token used is returned so caller can invalidate it if
+ * director rejected it.
+ */
+func (c *Client) vHttpSendReq(ctx context.Context,
+	method string,
+	urlStr string,
+	request []byte,
+	out interface{},
+	okStatus []int) ([]byte, string, error) {
 
-	/* form http GET request */
-	httpReq, _ := url.ParseRequestURI(apiUrl)
-	if len(urlData) > 0 {
-		httpReq.RawQuery = urlData.Encode()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
 	}
-	urlStr := fmt.Sprintf("%v", httpReq)
 
-	req, err := http.NewRequest("GET", urlStr, nil)
+	accessToken, err := c.tokens.AccessToken(ctx)
 	if err != nil {
-		fmt.Printf("Error creating http request %v\n", err)
-		return nil, err
+		tflog.Error(ctx, "Unable to get access token for "+method+" request: "+err.Error())
+		return nil, "", err
 	}
-	req.Header.Add("Accept", `application/json`)
-	req.Header.Set("Content-Type", "application/json")
-
-	bearer := "Bearer " + c.Token.AccessToken
-	req.Header.Add("Authorization", bearer)
 
-	resp, err := client.Do(req)
-	if err != nil {
-		fmt.Printf("Unable to send http GET request %v\n", err)
-		return nil, err
+	/* honour client side rate and concurrency limits */
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, accessToken, err
+		}
 	}
-	if resp.StatusCode != http.StatusOK {
-		tflog.Debug(ctx, "http response returned error "+resp.Status)
-		return nil, errors.New("http response error")
+	if c.inFlight != nil {
+		select {
+		case c.inFlight <- struct{}{}:
+			defer func() { <-c.inFlight }()
+		case <-ctx.Done():
+			return nil, accessToken, ctx.Err()
+		}
 	}
 
-	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 0x10000))
-	if err != nil {
-		fmt.Printf("Failed to read http response %v", err)
-		return nil, err
+	var reqBody io.Reader
+	if request != nil {
+		reqBody = bytes.NewReader(request)
 	}
-	defer resp.Body.Close()
-	//fmt.Printf("GET REQ DATA %v\n", string(body))
-
-	return body, nil
-}
-
-func (c *Client) vHttpHandlePostReq(ctx context.Context,
-	client *http.Client,
-	apiUrl string,
-	request []byte,
-	urlData url.Values) ([]byte, error) {
-
-	tflog.Debug(ctx, "POST Request: "+apiUrl)
-	tflog.Debug(ctx, "POST Request body: "+string(request))
-	/* form http POST request */
-	httpReq, _ := url.ParseRequestURI(apiUrl)
-	if len(urlData) > 0 {
-		httpReq.RawQuery = urlData.Encode()
-	}
-	urlStr := fmt.Sprintf("%v", httpReq)
-
-	req, err := http.NewRequest(http.MethodPost, urlStr, bytes.NewBuffer(request))
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
 	if err != nil {
-		tflog.Error(ctx, "Error in creating http request for POST")
-		return nil, err
+		tflog.Error(ctx, "Error in creating http request for "+method)
+		return nil, accessToken, err
 	}
 	req.Header.Add("Accept", `application/json`)
 	req.Header.Set("Content-Type", "application/json")
 
-	bearer := "Bearer " + c.Token.AccessToken
+	bearer := "Bearer " + accessToken
 	req.Header.Add("Authorization", bearer)
 
-	resp, err := client.Do(req)
+	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		tflog.Debug(ctx, "Error in sending POST request: "+err.Error())
-		return nil, err
+		tflog.Debug(ctx, "Error in sending "+method+" request: "+err.Error())
+		return nil, accessToken, err
 	}
-	if resp.StatusCode != http.StatusCreated {
-		tflog.Debug(ctx, "Error response for POST request: "+resp.Status)
-		return nil, errors.New("http response error")
+	defer resp.Body.Close()
+
+	statusOk := false
+	for _, status := range okStatus {
+		if resp.StatusCode == status {
+			statusOk = true
+			break
+		}
+	}
+	if !statusOk {
+		tflog.Debug(ctx, "Error response for "+method+" request: "+resp.Status)
+		return nil, accessToken, newAPIError(req, resp)
+	}
+
+	var respBody io.Reader = resp.Body
+	var limited *vLimitedReader
+	if c.maxBody > 0 {
+		limited = &vLimitedReader{reader: resp.Body, remaining: c.maxBody,
+			limit: c.maxBody, method: method, url: req.URL.Redacted()}
+		respBody = limited
 	}
 
-	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 0x10000))
+	var body []byte
+	if out != nil {
+		err = json.NewDecoder(respBody).Decode(out)
+		if err == io.EOF {
+			/* no content, nothing to decode */
+			err = nil
+		}
+	} else {
+		body, err = io.ReadAll(respBody)
+	}
+	if err == nil && limited != nil {
+		err = limited.exceeded()
+	}
 	if err != nil {
-		tflog.Debug(ctx, "Error reading POST response: "+err.Error())
-		return nil, err
+		tflog.Debug(ctx, "Error reading "+method+" response: "+err.Error())
+		return nil, accessToken, err
 	}
-	defer resp.Body.Close()
 
-	tflog.Debug(ctx, "POST request handled successfully")
-	return body, nil
+	tflog.Debug(ctx, method+" request handled successfully")
+	return body, accessToken, nil
 }
 
-func (c *Client) vHttpHandlePutReq(ctx context.Context,
-	client *http.Client,
+/*
+ * Common handler for http requests to director. If director rejects access
+ * token with 401 the token is renewed and request is sent once more.
+ * Throttled and transient failures are retried as per client retry policy.
+ */
+func (c *Client) vHttpHandleReq(ctx context.Context,
+	method string,
 	apiUrl string,
 	request []byte,
-	urlData url.Values) ([]byte, error) {
-
-	/* form http PUT request */
-	httpReq, _ := url.ParseRequestURI(apiUrl)
-	if len(urlData) > 0 {
-		httpReq.RawQuery = urlData.Encode()
-	}
-	urlStr := fmt.Sprintf("%v", httpReq)
+	urlData url.Values,
+	out interface{},
+	okStatus ...int) ([]byte, error) {
 
-	req, err := http.NewRequest(http.MethodPut, urlStr, bytes.NewBuffer(request))
+	/* form http request url */
+	httpReq, err := url.ParseRequestURI(apiUrl)
 	if err != nil {
-		tflog.Error(ctx, "Error in creating http request for PUT")
+		tflog.Error(ctx, "Invalid URL for "+method+" request: "+apiUrl)
 		return nil, err
 	}
-	req.Header.Add("Accept", `application/json`)
-	req.Header.Set("Content-Type", "application/json")
-
-	bearer := "Bearer " + c.Token.AccessToken
-	req.Header.Add("Authorization", bearer)
-
-	resp, err := client.Do(req)
-	if err != nil {
-		tflog.Debug(ctx, "Error in sending PUT request: "+err.Error())
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK &&
-		resp.StatusCode != http.StatusNoContent {
-		tflog.Debug(ctx, "Error response for PUT request: "+resp.Status)
-		return nil, errors.New("http response error")
+	if len(urlData) > 0 {
+		httpReq.RawQuery = urlData.Encode()
 	}
+	urlStr := httpReq.String()
+
+	renewed := false
+	for attempt := 1; ; attempt++ {
+		body, accessToken, err := c.vHttpSendReq(ctx, method, urlStr, request, out, okStatus)
+		if IsUnauthorized(err) && !renewed {
+			/* token expired or revoked on director, renew and retry once */
+			tflog.Debug(ctx, method+" request unauthorized, renewing access token")
+			c.tokens.Invalidate(accessToken)
+			renewed = true
+			attempt--
+			continue
+		}
 
-	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 0x10000))
-	if err != nil {
-		tflog.Debug(ctx, "Error reading PUT response: "+err.Error())
-		return nil, err
+		wait, retry := c.retry.retryAfter(ctx, method, err, attempt)
+		if !retry {
+			return body, err
+		}
+		tflog.Debug(ctx, "Retrying "+method+" request in "+wait.String()+
+			" after attempt "+strconv.Itoa(attempt)+" failed: "+err.Error())
+		if err := vSleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
-	defer resp.Body.Close()
+}
+
+/*
+ * Send GET request and decode json response into out.
+ */
+func (c *Client) vHttpHandleGetReq(ctx context.Context,
+	apiUrl string,
+	urlData url.Values,
+	out interface{}) error {
 
-	tflog.Debug(ctx, "PUT request handled successfully")
-	return body, nil
+	_, err := c.vHttpHandleReq(ctx, http.MethodGet, apiUrl, nil, urlData, out,
+		http.StatusOK)
+	return err
 }
 
-func (c *Client) vHttpHandleDeleteReq(ctx context.Context,
-	client *http.Client,
+func (c *Client) vHttpHandlePostReq(ctx context.Context,
 	apiUrl string,
 	request []byte,
 	urlData url.Values) ([]byte, error) {
 
-	/* form http POST request */
-	httpReq, _ := url.ParseRequestURI(apiUrl)
-	if len(urlData) > 0 {
-		httpReq.RawQuery = urlData.Encode()
-	}
-	urlStr := fmt.Sprintf("%v", httpReq)
-
-	req, err := http.NewRequest(http.MethodDelete, urlStr, bytes.NewBuffer(request))
-	if err != nil {
-		tflog.Error(ctx, "Error in creating http request for DELETE")
-		return nil, err
-	}
-	req.Header.Add("Accept", `application/json`)
-	req.Header.Set("Content-Type", "application/json")
+	tflog.Debug(ctx, "POST Request: "+apiUrl)
+	tflog.Debug(ctx, "POST Request body: "+string(request))
+	return c.vHttpHandleReq(ctx, http.MethodPost, apiUrl, request, urlData, nil,
+		http.StatusCreated)
+}
 
-	bearer := "Bearer " + c.Token.AccessToken
-	req.Header.Add("Authorization", bearer)
+func (c *Client) vHttpHandlePutReq(ctx context.Context,
+	apiUrl string,
+	request []byte,
+	urlData url.Values) ([]byte, error) {
 
-	resp, err := client.Do(req)
-	if err != nil {
-		tflog.Debug(ctx, "Error in sending DELETE request: "+err.Error())
-		return nil, err
-	}
-	if resp.StatusCode != http.StatusOK &&
-		resp.StatusCode != http.StatusNoContent {
-		tflog.Debug(ctx, "Error response for DELETE request: "+resp.Status)
-		return nil, errors.New("http response error")
-	}
+	return c.vHttpHandleReq(ctx, http.MethodPut, apiUrl, request, urlData, nil,
+		http.StatusOK, http.StatusNoContent)
+}
 
-	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 0x10000))
-	if err != nil {
-		tflog.Debug(ctx, "Error reading DELETE response: "+err.Error())
-		return nil, err
-	}
-	defer resp.Body.Close()
+func (c *Client) vHttpHandleDeleteReq(ctx context.Context,
+	apiUrl string,
+	request []byte,
+	urlData url.Values) ([]byte, error) {
 
-	tflog.Debug(ctx, "DELETE request handled successfully")
-	return body, nil
+	return c.vHttpHandleReq(ctx, http.MethodDelete, apiUrl, request, urlData, nil,
+		http.StatusOK, http.StatusNoContent)
 }
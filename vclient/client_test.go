@@ -0,0 +1,76 @@
+package vclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+/*
+ * Director stand-in for unit tests. Token requests are answered with
+ * tokens numbered from 1 and their grant types recorded, other requests
+ * are passed to handler.
+ */
+type vTestDirector struct {
+	server *httptest.Server
+	mu     sync.Mutex
+	grants []string
+}
+
+func newTestDirector(t *testing.T, handler http.HandlerFunc) *vTestDirector {
+	t.Helper()
+
+	d := &vTestDirector{}
+	d.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+vOauthServerTokenPath {
+			handler(w, r)
+			return
+		}
+		var params map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		d.mu.Lock()
+		d.grants = append(d.grants, params["grant_type"])
+		token := len(d.grants)
+		d.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "token-" + strconv.Itoa(token),
+			"refresh_token": "refresh",
+			"expires_in":    3600,
+		})
+	}))
+	t.Cleanup(d.server.Close)
+	return d
+}
+
+/* client of d with fast retries and a token cache private to the test */
+func (d *vTestDirector) client(t *testing.T, opts ...ClientOption) *Client {
+	t.Helper()
+
+	cache, err := NewFileTokenCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts = append([]ClientOption{
+		WithBaseURL(d.server.URL),
+		WithTokenCache(cache),
+		WithRetryPolicy(RetryPolicy{
+			MaxAttempts: 4,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  10 * time.Millisecond,
+		}),
+	}, opts...)
+
+	user, password, clientID, secret, grant := "user", "password", "client", "secret", "password"
+	c, err := NewClient(nil, &user, &password, nil, &clientID, &secret, &grant, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
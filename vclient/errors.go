@@ -0,0 +1,231 @@
+package vclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+/* amount of response body kept in APIError when it couldn't be parsed */
+const vApiErrorBodyLimit = 0x400
+
+/*
+ * Error returned for any non successful http response from director. It
+ * carries request details along with error code and message parsed from
+ * response body so callers can report them or react on status code.
+ */
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	Code       string
+	Message    string
+	RequestID  string
+	Body       string
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "%v %v: %v", e.Method, e.URL, e.Status)
+	if len(e.Code) > 0 {
+		fmt.Fprintf(&msg, " [%v]", e.Code)
+	}
+	if len(e.Message) > 0 {
+		fmt.Fprintf(&msg, ": %v", e.Message)
+	} else if len(e.Body) > 0 {
+		fmt.Fprintf(&msg, ": %v", e.Body)
+	}
+	if len(e.RequestID) > 0 {
+		fmt.Fprintf(&msg, " (request-id %v)", e.RequestID)
+	}
+	return msg.String()
+}
+
+/*
+ * Error formats returned by director. Config APIs return restconf style
+ * errors list, other APIs return either a nested error object or flat
+ * code and message.
+ */
+type vApiErrorBody struct {
+	Code      json.RawMessage `json:"code"`
+	Message   string          `json:"message"`
+	Error     json.RawMessage `json:"error"`
+	RequestID string          `json:"request_id"`
+	Errors    struct {
+		Error []struct {
+			Tag     string `json:"error-tag"`
+			Type    string `json:"error-type"`
+			Path    string `json:"error-path"`
+			Message string `json:"error-message"`
+		} `json:"error"`
+	} `json:"errors"`
+}
+
+type vApiErrorObject struct {
+	Code        json.RawMessage `json:"code"`
+	Message     string          `json:"message"`
+	Description string          `json:"description"`
+	RequestID   string          `json:"request_id"`
+}
+
+/* convert json code which may be number or string to printable string */
+func vApiErrorCode(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var code string
+	if err := json.Unmarshal(raw, &code); err == nil {
+		return code
+	}
+	return string(raw)
+}
+
+/*
+ * Build APIError from http response. Response body is consumed but not
+ * closed, caller owns the response.
+ */
+func newAPIError(req *http.Request, resp *http.Response) *APIError {
+	apiErr := &APIError{
+		Method:     req.Method,
+		URL:        req.URL.Redacted(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		RetryAfter: vParseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, vApiErrorBodyLimit))
+	apiErr.Body = strings.TrimSpace(string(body))
+
+	var errBody vApiErrorBody
+	if err := json.Unmarshal(body, &errBody); err != nil {
+		return apiErr
+	}
+
+	apiErr.Code = vApiErrorCode(errBody.Code)
+	apiErr.Message = errBody.Message
+	if len(apiErr.RequestID) == 0 {
+		apiErr.RequestID = errBody.RequestID
+	}
+
+	if len(errBody.Errors.Error) > 0 {
+		restErr := errBody.Errors.Error[0]
+		apiErr.Code = restErr.Tag
+		apiErr.Message = restErr.Message
+		if len(restErr.Path) > 0 {
+			apiErr.Message += " (" + restErr.Path + ")"
+		}
+	} else if len(errBody.Error) > 0 {
+		var errObject vApiErrorObject
+		if err := json.Unmarshal(errBody.Error, &errObject); err == nil {
+			if code := vApiErrorCode(errObject.Code); len(code) > 0 {
+				apiErr.Code = code
+			}
+			if len(errObject.Message) > 0 {
+				apiErr.Message = errObject.Message
+			} else if len(errObject.Description) > 0 {
+				apiErr.Message = errObject.Description
+			}
+			if len(apiErr.RequestID) == 0 {
+				apiErr.RequestID = errObject.RequestID
+			}
+		} else if len(apiErr.Message) == 0 {
+			var errString string
+			if err := json.Unmarshal(errBody.Error, &errString); err == nil {
+				apiErr.Message = errString
+			}
+		}
+	}
+
+	return apiErr
+}
+
+/* check http status code of APIError wrapped in err */
+func vApiErrorHasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an APIError for a missing object.
+func IsNotFound(err error) bool {
+	return vApiErrorHasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an APIError for an object that
+// already exists or was modified concurrently.
+func IsConflict(err error) bool {
+	return vApiErrorHasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is an APIError for a missing or
+// expired access token.
+func IsUnauthorized(err error) bool {
+	return vApiErrorHasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError for an operation the
+// user isn't allowed to perform.
+func IsForbidden(err error) bool {
+	return vApiErrorHasStatus(err, http.StatusForbidden)
+}
+
+// ResponseTooLargeError is returned when director response is larger
+// than maximum response size configured for client.
+type ResponseTooLargeError struct {
+	Method string
+	URL    string
+	Limit  int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%v %v: response exceeds maximum size of %v bytes",
+		e.Method, e.URL, e.Limit)
+}
+
+/*
+ * Reader failing with ResponseTooLargeError once more than limit bytes
+ * are read, so truncated data is never handed to json decoder silently.
+ */
+type vLimitedReader struct {
+	reader    io.Reader
+	remaining int64
+	limit     int64
+	method    string
+	url       string
+}
+
+/*
+ * Error of a reader which went past limit. Decoders may ignore a read
+ * error once they got a complete value, so it is checked after decoding.
+ */
+func (l *vLimitedReader) exceeded() error {
+	if l.remaining < 0 {
+		return &ResponseTooLargeError{Method: l.method, URL: l.url, Limit: l.limit}
+	}
+	return nil
+}
+
+func (l *vLimitedReader) Read(p []byte) (int, error) {
+	if err := l.exceeded(); err != nil {
+		return 0, err
+	}
+	/* read one byte past limit to detect oversized response */
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, l.exceeded()
+	}
+	return n, err
+}
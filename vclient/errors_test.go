@@ -0,0 +1,196 @@
+package vclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	body := `[{"name":"org-0"},{"name":"org-1"}]`
+	tests := map[string]struct {
+		limit int64
+		fail  bool
+	}{
+		"no limit":    {limit: 0},
+		"under limit": {limit: int64(len(body)) + 10},
+		"at limit":    {limit: int64(len(body))},
+		"over limit":  {limit: int64(len(body)) - 1, fail: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Write([]byte(body))
+			})
+			c := d.client(t, WithMaxResponseSize(test.limit))
+			c.Pagination.PageSize = 2
+
+			orgs, err := c.GetAllOrganizations(context.Background())
+			var sizeErr *ResponseTooLargeError
+			if !test.fail {
+				if err != nil || len(orgs) != 2 {
+					t.Errorf("got %v organizations, error %v", len(orgs), err)
+				}
+				return
+			}
+			if !errors.As(err, &sizeErr) || sizeErr.Limit != test.limit {
+				t.Fatalf("got error %v, want ResponseTooLargeError", err)
+			}
+			/* oversized response isn't retried */
+			if got := requests.Load(); got != 1 {
+				t.Errorf("got %v requests, want 1", got)
+			}
+		})
+	}
+}
+
+func TestLimitedReader(t *testing.T) {
+	tests := map[string]struct {
+		data  string
+		limit int64
+		fail  bool
+	}{
+		"empty":      {data: "", limit: 4},
+		"under":      {data: "abc", limit: 4},
+		"exact":      {data: "abcd", limit: 4},
+		"one over":   {data: "abcde", limit: 4, fail: true},
+		"large over": {data: strings.Repeat("x", 10000), limit: 100, fail: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reader := &vLimitedReader{reader: strings.NewReader(test.data),
+				remaining: test.limit, limit: test.limit, method: "GET", url: "/test"}
+			data, err := io.ReadAll(reader)
+
+			var sizeErr *ResponseTooLargeError
+			if test.fail != errors.As(err, &sizeErr) {
+				t.Fatalf("got error %v, want failure %v", err, test.fail)
+			}
+			if !test.fail && string(data) != test.data {
+				t.Errorf("got %q, want %q", data, test.data)
+			}
+			if int64(len(data)) > test.limit+1 {
+				t.Errorf("read %v bytes past limit %v", len(data), test.limit)
+			}
+		})
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := map[string]struct {
+		body      string
+		header    http.Header
+		code      string
+		message   string
+		requestID string
+	}{
+		"restconf": {
+			body: `{"errors":{"error":[{"error-tag":"data-exists","error-type":"application",` +
+				`"error-path":"/objects/addresses/address","error-message":"object exists"}]}}`,
+			code:    "data-exists",
+			message: "object exists (/objects/addresses/address)",
+		},
+		"nested": {
+			body:      `{"error":{"code":4001,"message":"invalid name","request_id":"r-1"}}`,
+			code:      "4001",
+			message:   "invalid name",
+			requestID: "r-1",
+		},
+		"nested description": {
+			body:    `{"error":{"code":"E1","description":"bad request"}}`,
+			code:    "E1",
+			message: "bad request",
+		},
+		"nested string": {
+			body:    `{"error":"invalid_grant"}`,
+			message: "invalid_grant",
+		},
+		"flat": {
+			body:      `{"code":"404","message":"not found","request_id":"r-2"}`,
+			code:      "404",
+			message:   "not found",
+			requestID: "r-2",
+		},
+		"request id header": {
+			body:      `{"code":"404","message":"not found","request_id":"r-2"}`,
+			header:    http.Header{"X-Request-Id": {"r-3"}},
+			code:      "404",
+			message:   "not found",
+			requestID: "r-3",
+		},
+		"not json": {
+			body: "<html>Bad Gateway</html>",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "https://director/api/test", nil)
+			header := test.header
+			if header == nil {
+				header = http.Header{}
+			}
+			resp := &http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request",
+				Header: header, Body: io.NopCloser(strings.NewReader(test.body))}
+
+			apiErr := newAPIError(req, resp)
+			if apiErr.Code != test.code || apiErr.Message != test.message ||
+				apiErr.RequestID != test.requestID {
+				t.Errorf("got code %q message %q request-id %q, want %q %q %q",
+					apiErr.Code, apiErr.Message, apiErr.RequestID,
+					test.code, test.message, test.requestID)
+			}
+			if apiErr.Body != test.body {
+				t.Errorf("got body %q, want %q", apiErr.Body, test.body)
+			}
+			if apiErr.StatusCode != http.StatusBadRequest || apiErr.Method != http.MethodGet {
+				t.Errorf("got %v %v", apiErr.Method, apiErr.StatusCode)
+			}
+		})
+	}
+}
+
+func TestNewAPIErrorRetryAfter(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://director/api/test", nil)
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{"Retry-After": {"3"}}, Body: io.NopCloser(strings.NewReader(""))}
+	if got := newAPIError(req, resp).RetryAfter; got != 3*time.Second {
+		t.Errorf("got %v, want 3s", got)
+	}
+}
+
+func TestAPIErrorStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		check      func(error) bool
+		statusCode int
+	}{
+		{name: "IsNotFound", check: IsNotFound, statusCode: http.StatusNotFound},
+		{name: "IsConflict", check: IsConflict, statusCode: http.StatusConflict},
+		{name: "IsUnauthorized", check: IsUnauthorized, statusCode: http.StatusUnauthorized},
+		{name: "IsForbidden", check: IsForbidden, statusCode: http.StatusForbidden},
+	}
+	for _, test := range tests {
+		for _, other := range tests {
+			err := error(&APIError{StatusCode: other.statusCode})
+			want := test.statusCode == other.statusCode
+			if got := test.check(err); got != want {
+				t.Errorf("%v(%v): got %v, want %v", test.name, other.statusCode, got, want)
+			}
+			/* wrapped errors are recognised too */
+			if got := test.check(fmt.Errorf("address a: %w", err)); got != want {
+				t.Errorf("%v(wrapped %v): got %v, want %v", test.name, other.statusCode, got, want)
+			}
+		}
+		if test.check(nil) || test.check(errors.New("connection refused")) {
+			t.Errorf("%v: got true for non APIError", test.name)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package vclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/* default retry policy used when provider doesn't override it */
+const (
+	vDefaultRetryAttempts   = 4
+	vDefaultRetryMinBackoff = 1 * time.Second
+	vDefaultRetryMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests to director are retried.
+// MaxAttempts counts the first attempt too, so 1 disables retries. GET,
+// PUT and DELETE are retried on throttling, gateway and connection errors;
+// POST only when director didn't process it (429, 503 or no connection).
+// Retry-After sent by director is honoured up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+// DefaultRetryPolicy returns retry policy used by NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: vDefaultRetryAttempts,
+		MinBackoff:  vDefaultRetryMinBackoff,
+		MaxBackoff:  vDefaultRetryMaxBackoff,
+	}
+}
+
+// WithRetryPolicy sets retry policy for requests to director.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.retry = policy
+		return nil
+	}
+}
+
+// WithRateLimit limits requests sent to director using a token bucket
+// refilled at requestsPerSecond and holding up to burst requests. Zero
+// rate disables the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return nil
+		}
+		if burst <= 0 {
+			burst = 1
+		}
+		c.limiter = &vRateLimiter{
+			rate:   requestsPerSecond,
+			burst:  float64(burst),
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+		return nil
+	}
+}
+
+// WithMaxInFlight limits number of requests outstanding on director at
+// the same time. Zero means no limit.
+func WithMaxInFlight(maxInFlight int) ClientOption {
+	return func(c *Client) error {
+		c.inFlight = nil
+		if maxInFlight > 0 {
+			c.inFlight = make(chan struct{}, maxInFlight)
+		}
+		return nil
+	}
+}
+
+func vHttpMethodIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+/*
+ * Check whether a failed request can be sent again and how long to wait
+ * before doing so. attempt is number of attempts made so far.
+ */
+func (p RetryPolicy) retryAfter(ctx context.Context, method string,
+	err error, attempt int) (time.Duration, bool) {
+
+	if err == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		case http.StatusBadGateway, http.StatusGatewayTimeout:
+			if !vHttpMethodIdempotent(method) {
+				return 0, false
+			}
+		default:
+			return 0, false
+		}
+		return p.backoff(attempt, apiErr.RetryAfter), true
+	}
+
+	/* only network failures are retried, not decoding or size errors */
+	var netErr net.Error
+	if !errors.As(err, &netErr) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, false
+	}
+
+	/* transport error, POST is safe only if connection wasn't made */
+	if !vHttpMethodIdempotent(method) {
+		var opErr *net.OpError
+		if !errors.As(err, &opErr) || opErr.Op != "dial" {
+			return 0, false
+		}
+	}
+	return p.backoff(attempt, 0), true
+}
+
+/*
+ * Exponential backoff with jitter. Wait asked by director in Retry-After
+ * is honoured if it is longer, up to MaxBackoff so a bogus header can't
+ * stall the provider.
+ */
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	minBackoff := p.MinBackoff
+	if minBackoff <= 0 {
+		minBackoff = vDefaultRetryMinBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	backoff := float64(minBackoff) * math.Pow(2, float64(attempt-1))
+	backoff = math.Min(backoff, float64(maxBackoff))
+	/* use random wait in upper half so clients don't retry in lockstep */
+	wait := time.Duration(backoff/2 + rand.Float64()*backoff/2)
+
+	if retryAfter > maxBackoff {
+		return maxBackoff
+	}
+	if retryAfter > wait {
+		return retryAfter
+	}
+	return wait
+}
+
+/*
+ * Parse Retry-After header value, either delay in seconds or http date.
+ */
+func vParseRetryAfter(value string) time.Duration {
+	if len(value) == 0 {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if retryTime, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(retryTime); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+/* sleep for wait unless ctx is done first */
+func vSleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+/*
+ * Token bucket limiting rate of requests sent to director.
+ */
+type vRateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+/* wait until a request may be sent */
+func (l *vRateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := vSleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package vclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+/* handler failing with status for the first failures requests */
+func testFailingHandler(status int, failures int32, retryAfter string,
+	requests *atomic.Int32) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			if len(retryAfter) > 0 {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		json.NewEncoder(w).Encode([]VmsDirectorOrganization{})
+	}
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		failures int32
+		requests int32
+		fail     bool
+	}{
+		"throttled":         {status: http.StatusTooManyRequests, failures: 2, requests: 3},
+		"unavailable":       {status: http.StatusServiceUnavailable, failures: 1, requests: 2},
+		"bad gateway":       {status: http.StatusBadGateway, failures: 1, requests: 2},
+		"attempts exceeded": {status: http.StatusServiceUnavailable, failures: 10, requests: 4, fail: true},
+		"not retried":       {status: http.StatusInternalServerError, failures: 1, requests: 1, fail: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			d := newTestDirector(t, testFailingHandler(test.status, test.failures, "", &requests))
+			c := d.client(t)
+
+			_, err := c.GetAllOrganizations(context.Background())
+			if test.fail && !vApiErrorHasStatus(err, test.status) {
+				t.Errorf("got error %v, want status %v", err, test.status)
+			} else if !test.fail && err != nil {
+				t.Error(err)
+			}
+			if got := requests.Load(); got != test.requests {
+				t.Errorf("got %v requests, want %v", got, test.requests)
+			}
+		})
+	}
+}
+
+func TestRetryPostOnlyUnprocessed(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		requests int32
+	}{
+		"throttled":   {status: http.StatusTooManyRequests, requests: 2},
+		"bad gateway": {status: http.StatusBadGateway, requests: 1},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			d := newTestDirector(t, testFailingHandler(test.status, 1, "", &requests))
+			c := d.client(t)
+
+			c.vHttpHandlePostReq(context.Background(), c.vApiURL("test"), []byte("{}"), nil)
+			if got := requests.Load(); got != test.requests {
+				t.Errorf("got %v requests, want %v", got, test.requests)
+			}
+		})
+	}
+}
+
+func TestRetryAfterHonoured(t *testing.T) {
+	var requests atomic.Int32
+	d := newTestDirector(t, testFailingHandler(http.StatusTooManyRequests, 1, "1", &requests))
+	c := d.client(t, WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+	}))
+
+	start := time.Now()
+	if _, err := c.GetAllOrganizations(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least 1s", elapsed)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+	tests := []struct {
+		attempt    int
+		retryAfter time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{attempt: 1, min: 500 * time.Millisecond, max: time.Second},
+		{attempt: 2, min: time.Second, max: 2 * time.Second},
+		{attempt: 5, min: 2 * time.Second, max: 4 * time.Second},
+		{attempt: 1, retryAfter: 3 * time.Second, min: 3 * time.Second, max: 3 * time.Second},
+		{attempt: 1, retryAfter: 10 * time.Second, min: 4 * time.Second, max: 4 * time.Second},
+		{attempt: 1, retryAfter: time.Hour, min: 4 * time.Second, max: 4 * time.Second},
+	}
+	for _, test := range tests {
+		wait := policy.backoff(test.attempt, test.retryAfter)
+		if wait < test.min || wait > test.max {
+			t.Errorf("attempt %v retry-after %v: got %v, want %v to %v",
+				test.attempt, test.retryAfter, wait, test.min, test.max)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":        0,
+		"5":       5 * time.Second,
+		"-1":      0,
+		"invalid": 0,
+		time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat): 0,
+	}
+	for value, want := range tests {
+		if got := vParseRetryAfter(value); got != want {
+			t.Errorf("%q: got %v, want %v", value, got, want)
+		}
+	}
+
+	future := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if got := vParseRetryAfter(future); got < 59*time.Minute || got > time.Hour {
+		t.Errorf("%q: got %v, want about 1h", future, got)
+	}
+}
@@ -0,0 +1,90 @@
+package vclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// TLSOptions controls how client verifies director certificate and which
+// certificate it presents for mutual TLS. Certificates and key are PEM
+// encoded.
+type TLSOptions struct {
+	Insecure      bool
+	CACertPEM     []byte
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	ServerName    string
+}
+
+/*
+ * Build tls configuration from options. System root CAs are used unless
+ * a CA bundle is given.
+ */
+func (o TLSOptions) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.Insecure,
+		ServerName:         o.ServerName,
+	}
+
+	if len(o.CACertPEM) > 0 {
+		caPool := x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(o.CACertPEM) {
+			return nil, errors.New("no valid certificates found in CA bundle")
+		}
+		tlsConfig.RootCAs = caPool
+	}
+
+	if len(o.ClientCertPEM) > 0 || len(o.ClientKeyPEM) > 0 {
+		if len(o.ClientCertPEM) == 0 || len(o.ClientKeyPEM) == 0 {
+			return nil, errors.New("both client certificate and client key are needed for mutual TLS")
+		}
+		cert, err := tls.X509KeyPair(o.ClientCertPEM, o.ClientKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+/*
+ * Create transport dedicated to this client. Global default transport is
+ * never modified so other users of net/http aren't affected. Idle
+ * connections to director are kept so requests reuse TLS sessions.
+ */
+func vHttpTransport(tlsConfig *tls.Config) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   32,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+}
+
+// WithTLS sets TLS options used for all connections to director.
+// Director certificate is verified against system roots by default.
+func WithTLS(options TLSOptions) ClientOption {
+	return func(c *Client) error {
+		tlsConfig, err := options.tlsConfig()
+		if err != nil {
+			return err
+		}
+		c.transport.TLSClientConfig = tlsConfig
+		return nil
+	}
+}
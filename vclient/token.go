@@ -0,0 +1,248 @@
+package vclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/* token is renewed when it is this close to expiry */
+const vOauthTokenRefreshWindow = 60 * time.Second
+
+/*
+ * Token source keeps OAUTH2 token used by client. Token is renewed with
+ * refresh_token grant before it expires, falling back to configured grant
+ * type if refresh is rejected. Access is serialized so concurrent resource
+ * operations share a single renewal.
+ */
+type vTokenSource struct {
+	mu     sync.Mutex
+	client *Client
+	cache  TokenCache
+	token  vOauthServerToken
+	expiry time.Time
+}
+
+/*
+ * Token data kept in token cache. Expiry is stored along with token as
+ * issue time sent by server isn't always available.
+ */
+type vTokenCacheEntry struct {
+	Token  vOauthServerToken `json:"token"`
+	Expiry time.Time         `json:"expiry"`
+}
+
+/*
+ * Get expiry time of token. Zero time is returned for tokens which never
+ * expire. Token issue time is taken from received when known, otherwise
+ * from issued_at sent by server; false is returned if neither is usable.
+ */
+func vOauthTokenExpiry(token vOauthServerToken, received time.Time) (time.Time, bool) {
+	if len(token.ExpiresIn) == 0 {
+		return time.Time{}, !received.IsZero()
+	}
+	expiresIn, err := token.ExpiresIn.Int64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	if expiresIn <= 0 {
+		return time.Time{}, true
+	}
+
+	issued := received
+	if issued.IsZero() {
+		issuedAt, err := token.IssuedAt.Int64()
+		if err != nil || issuedAt <= 0 {
+			return time.Time{}, false
+		}
+		/* director sends epoch in milliseconds */
+		if issuedAt > 1e12 {
+			issued = time.UnixMilli(issuedAt)
+		} else {
+			issued = time.Unix(issuedAt, 0)
+		}
+	}
+	return issued.Add(time.Duration(expiresIn) * time.Second), true
+}
+
+func (ts *vTokenSource) set(token vOauthServerToken, expiry time.Time) {
+	ts.token = token
+	ts.expiry = expiry
+}
+
+func (ts *vTokenSource) cacheKey() string {
+	return vTokenCacheKey(&ts.client.Config, ts.client.HostURL)
+}
+
+/*
+ * Load token from token cache. Expired tokens are still loaded so their
+ * refresh token can be used.
+ */
+func (ts *vTokenSource) load() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	data, ok := ts.cache.Get(ts.cacheKey())
+	if !ok {
+		return
+	}
+	var entry vTokenCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		log.Printf("Ignoring invalid cached token %v\n", err)
+		return
+	}
+	log.Printf("Using cached OAUTH token, expiry %v\n", entry.Expiry)
+	ts.set(entry.Token, entry.Expiry)
+}
+
+func (ts *vTokenSource) store() {
+	data, err := json.Marshal(vTokenCacheEntry{Token: ts.token, Expiry: ts.expiry})
+	if err != nil {
+		return
+	}
+	if err := ts.cache.Put(ts.cacheKey(), data); err != nil {
+		log.Printf("Failed to cache token data %v\n", err)
+	}
+}
+
+func (ts *vTokenSource) valid() bool {
+	if len(ts.token.AccessToken) == 0 {
+		return false
+	}
+	return ts.expiry.IsZero() ||
+		time.Now().Add(vOauthTokenRefreshWindow).Before(ts.expiry)
+}
+
+/*
+ * Get access token for a request, renewing it first if it is missing or
+ * about to expire.
+ */
+func (ts *vTokenSource) AccessToken(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if !ts.valid() {
+		if err := ts.renew(ctx); err != nil {
+			return "", err
+		}
+	}
+	return ts.token.AccessToken, nil
+}
+
+/*
+ * Mark access token rejected by server as unusable. Token is dropped only
+ * if it wasn't already renewed by another request.
+ */
+func (ts *vTokenSource) Invalidate(accessToken string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token.AccessToken == accessToken {
+		ts.token.AccessToken = ""
+	}
+}
+
+func (ts *vTokenSource) renew(ctx context.Context) error {
+	config := &ts.client.Config
+	if len(config.UserName) == 0 {
+		return errors.New("versadirector credentials are not configured")
+	}
+
+	if len(ts.token.RefreshToken) > 0 {
+		refreshParams := map[string]string{
+			"client_id":     config.ClientID,
+			"client_secret": config.ClientSecret,
+			"grant_type":    "refresh_token",
+			"refresh_token": ts.token.RefreshToken,
+		}
+		if err := ts.request(ctx, refreshParams); err == nil {
+			return nil
+		} else {
+			log.Printf("Unable to refresh OAUTH token, requesting new one %v\n", err)
+		}
+	}
+
+	oauthParams := map[string]string{
+		"client_id":     config.ClientID,
+		"client_secret": config.ClientSecret,
+		"grant_type":    config.GrantType,
+		"username":      config.UserName,
+		"password":      config.Password,
+	}
+	return ts.request(ctx, oauthParams)
+}
+
+/*
+ * Send token request to OAUTH2 server and keep the received token. Token
+ * data is also stored in token cache to be reused by later clients.
+ */
+func (ts *vTokenSource) request(ctx context.Context, oauthParams map[string]string) error {
+	log.Printf("Get OAUTH token for versadirector grant-type %v\n",
+		oauthParams["grant_type"])
+	requestBody, err := json.Marshal(oauthParams)
+	if err != nil {
+		log.Printf("Unable to marshal oauth-parameters %v\n", err)
+		return err
+	}
+
+	oauthServerUrl := ts.client.vApiURL(vOauthServerTokenPath)
+
+	if ts.client.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ts.client.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		oauthServerUrl, bytes.NewReader(requestBody))
+	if err != nil {
+		log.Printf("Unable to create token request %v\n", err)
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	received := time.Now()
+	resp, err := ts.client.HTTPClient.Do(req)
+	if err != nil {
+		log.Printf("Unable to send POST request to get token %v\n", err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(req, resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("Unable to read response from OAUTH server for token %v\n", err)
+		return err
+	}
+
+	var tokenData vOauthServerToken
+	if err := json.Unmarshal(body, &tokenData); err != nil {
+		log.Printf("Unable to unmarshal token response %v\n", err)
+		return err
+	}
+	if len(tokenData.AccessToken) == 0 {
+		return errors.New("OAUTH server response doesn't include access token")
+	}
+	/* refresh response may not carry a new refresh token */
+	if len(tokenData.RefreshToken) == 0 {
+		tokenData.RefreshToken = ts.token.RefreshToken
+	}
+
+	expiry, _ := vOauthTokenExpiry(tokenData, received)
+	ts.set(tokenData, expiry)
+
+	vOauthTokenDisplay(tokenData)
+	ts.store()
+
+	return nil
+}
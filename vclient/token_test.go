@@ -0,0 +1,71 @@
+package vclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenRenewedOnUnauthorized(t *testing.T) {
+	var requests atomic.Int32
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			http.Error(w, "token expired", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode([]VmsDirectorOrganization{})
+	})
+	c := d.client(t)
+
+	if _, err := c.GetAllOrganizations(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %v requests, want 2", got)
+	}
+	if want := []string{"password", "refresh_token"}; !reflect.DeepEqual(d.grants, want) {
+		t.Errorf("got grants %v, want %v", d.grants, want)
+	}
+}
+
+func TestTokenRenewedOnceOnUnauthorized(t *testing.T) {
+	var requests atomic.Int32
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Error(w, "token rejected", http.StatusUnauthorized)
+	})
+	c := d.client(t)
+
+	_, err := c.GetAllOrganizations(context.Background())
+	if !IsUnauthorized(err) {
+		t.Fatalf("got error %v, want unauthorized", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %v requests, want 2", got)
+	}
+}
+
+func TestTokenRefreshedBeforeExpiry(t *testing.T) {
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			http.Error(w, "token expired", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode([]VmsDirectorOrganization{})
+	})
+	c := d.client(t)
+	/* token about to expire is renewed before it is used */
+	c.tokens.expiry = time.Now().Add(vOauthTokenRefreshWindow / 2)
+
+	if _, err := c.GetAllOrganizations(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"password", "refresh_token"}; !reflect.DeepEqual(d.grants, want) {
+		t.Errorf("got grants %v, want %v", d.grants, want)
+	}
+}
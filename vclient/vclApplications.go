@@ -0,0 +1,77 @@
+package vclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+/*
+ * Predefined applications of the security pack installed on director,
+ * e.g. vnms/spack/predefined?xPath=/predefined/appid/applications/application
+ */
+const (
+	vmsDirectorPredefinedURL       = "vnms/spack/predefined"
+	vmsDirectorPredefinedAppsXPath = "/predefined/appid/applications/application"
+)
+
+/*
+ * Predefined application of the security pack. Name is the application
+ * ID referenced by policies, e.g. FACEBOOK.
+ */
+type VmsDirectorApplication struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Family       string `json:"family"`
+	SubFamily    string `json:"subfamily"`
+	Risk         int64  `json:"risk"`
+	Productivity int64  `json:"productivity"`
+}
+
+/*
+ * Predefined applications only change with security pack updates, so the
+ * list is fetched once per client and shared by all callers.
+ */
+type vPredefinedApplications struct {
+	mu   sync.Mutex
+	list []VmsDirectorApplication
+}
+
+/*
+ * Get all predefined applications from director, walking every page of
+ * the list. Security pack API doesn't report total count, so pages are
+ * requested until director returns a short or repeated one.
+ */
+func (c *Client) GetAllPredefinedApplications(ctx context.Context) ([]VmsDirectorApplication, error) {
+
+	c.apps.mu.Lock()
+	defer c.apps.mu.Unlock()
+	if c.apps.list != nil {
+		return c.apps.list, nil
+	}
+
+	apiUrl := c.vApiURL(vmsDirectorPredefinedURL)
+
+	fetch := func(ctx context.Context, offset int, limit int) ([]VmsDirectorApplication, int, error) {
+		urlData := url.Values{}
+		urlData.Set("xPath", vmsDirectorPredefinedAppsXPath)
+		urlData.Add("limit", strconv.Itoa(limit))
+		urlData.Add("offset", strconv.Itoa(offset))
+
+		appsData := struct {
+			Applications []VmsDirectorApplication `json:"application"`
+		}{}
+		if err := c.vHttpHandleGetReq(ctx, apiUrl, urlData, &appsData); err != nil {
+			return nil, -1, err
+		}
+		return appsData.Applications, -1, nil
+	}
+
+	apps, err := vPaginate(ctx, c.Pagination, fetch)
+	if err != nil {
+		return nil, err
+	}
+	c.apps.list = append([]VmsDirectorApplication{}, apps...)
+	return c.apps.list, nil
+}
@@ -0,0 +1,19 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationAddressGroup(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectAddressGroup, error) {
+
+	return vDevOrgObjectGet[DevObjectAddressGroup](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsAddressGroups, name)
+}
+
+func (c *Client) GetDeviceOrganizationAddressGroups(ctx context.Context,
+	deviceName string, organizationName string) ([]DevObjectAddressGroup, error) {
+
+	return vDevOrgObjectGetAll[DevObjectAddressGroup](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsAddressGroups)
+}
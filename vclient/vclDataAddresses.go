@@ -2,9 +2,8 @@ package vclient
 
 import (
 	"context"
-	"encoding/json"
 	"log"
-	"strconv"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -12,31 +11,42 @@ import (
 func (c *Client) GetDeviceOrganizationAddresses(ctx context.Context,
 	deviceName string, organizationName string) (*DevObjectsAddressList, error) {
 
-	client, _, err := vHttpClient(c.Config.ServerIP, c.Config.ServerPort, "")
-	if err != nil {
-		log.Printf("Unable to create http-client %v\n", err)
+	httpUrl := c.vDevOrgServicesURL(deviceName, organizationName,
+		vmsDirectorObjectsAddressesURL, "address")
+	tflog.Debug(ctx, "CLIENT-DATA GET URL: "+httpUrl)
+
+	addrListData := DevOjectsAddressListData{}
+	if err := c.vHttpHandleGetReq(ctx, httpUrl, nil, &addrListData.AddrList); err != nil {
+		log.Printf("HTTP GET failed for URL: %v, error: %v", httpUrl, err)
 		return nil, err
 	}
+	tflog.Debug(ctx, "CLIENT-DATA GET SUCECSSFUL for URL: "+httpUrl)
+	return &addrListData.AddrList, nil
+}
+
+/*
+ * Get a single address object by name. Director answers 404 when the
+ * object, organization or device doesn't exist, check with IsNotFound.
+ */
+func (c *Client) GetDeviceOrganizationAddress(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectAddress, error) {
 
-	httpUrl := "https://" + c.Config.ServerIP + ":" + strconv.Itoa(c.Config.ServerPort) + "/" +
-		vmsDirectorDevicesURL + "/" +
-		deviceName + "/" +
-		vmsDirectorOrgServicesURL + "/" +
-		organizationName + "/" +
-		vmsDirectorObjectsAddressesURL + "/" + "address"
+	httpUrl := c.vDevOrgServicesURL(deviceName, organizationName,
+		vmsDirectorObjectsAddressesURL, "address", vPathSegment(name))
 	tflog.Debug(ctx, "CLIENT-DATA GET URL: "+httpUrl)
 
-	if data, err := c.vHttpHandleGetReq(ctx, client, httpUrl, nil); err != nil {
+	var addrList DevObjectsAddressList
+	if err := c.vHttpHandleGetReq(ctx, httpUrl, nil, &addrList); err != nil {
 		log.Printf("HTTP GET failed for URL: %v, error: %v", httpUrl, err)
 		return nil, err
-	} else {
-		tflog.Debug(ctx, "CLIENT-DATA GET SUCECSSFUL fot=r URL: "+httpUrl)
-		addrListData := DevOjectsAddressListData{}
-		if err := json.Unmarshal([]byte(data), &addrListData.AddrList); err != nil {
-			log.Printf("Unmarshal failed for address data, error %v\n", err)
-			return nil, err
+	}
+	for _, val := range addrList.Addresses {
+		if val.Name == name {
+			tflog.Debug(ctx, "CLIENT-DATA GET SUCECSSFUL for URL: "+httpUrl)
+			return &val, nil
 		}
-		//vDisplayResponse(data)
-		return &addrListData.AddrList, nil
 	}
+	return nil, &APIError{Method: http.MethodGet, URL: httpUrl,
+		StatusCode: http.StatusNotFound, Status: "404 Not Found",
+		Message: "address " + name + " not found"}
 }
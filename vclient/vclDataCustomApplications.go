@@ -0,0 +1,19 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationCustomApplication(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevCustomApplication, error) {
+
+	return vDevOrgObjectGet[DevCustomApplication](ctx, c, deviceName, organizationName,
+		vmsDirectorCustomApplications, name)
+}
+
+func (c *Client) GetDeviceOrganizationCustomURLCategory(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevCustomURLCategory, error) {
+
+	return vDevOrgObjectGet[DevCustomURLCategory](ctx, c, deviceName, organizationName,
+		vmsDirectorCustomURLCategories, name)
+}
@@ -0,0 +1,29 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationNATPool(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevNATPool, error) {
+
+	return vDevOrgObjectGet[DevNATPool](ctx, c, deviceName, organizationName,
+		vmsDirectorNATPools, name)
+}
+
+func (c *Client) GetDeviceOrganizationNATRule(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevNATRule, error) {
+
+	return vDevOrgObjectGet[DevNATRule](ctx, c, deviceName, organizationName,
+		vmsDirectorNATRules, name)
+}
+
+/*
+ * Get NAT rules in evaluation order.
+ */
+func (c *Client) GetDeviceOrganizationNATRules(ctx context.Context,
+	deviceName string, organizationName string) ([]DevNATRule, error) {
+
+	return vDevOrgObjectGetAll[DevNATRule](ctx, c, deviceName, organizationName,
+		vmsDirectorNATRules)
+}
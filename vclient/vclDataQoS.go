@@ -0,0 +1,37 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationQoSProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevQoSProfile, error) {
+
+	return vDevOrgObjectGet[DevQoSProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorQoSProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationCoSClassifier(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevCoSClassifier, error) {
+
+	return vDevOrgObjectGet[DevCoSClassifier](ctx, c, deviceName, organizationName,
+		vmsDirectorCoSClassifiers, name)
+}
+
+func (c *Client) GetDeviceOrganizationAppQoSRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	name string) (*DevAppQoSRule, error) {
+
+	return vDevOrgObjectGet[DevAppQoSRule](ctx, c, deviceName, organizationName,
+		vmsDirectorAppQoSRules(policyName), name)
+}
+
+/*
+ * Get rules of an app-QoS policy in evaluation order.
+ */
+func (c *Client) GetDeviceOrganizationAppQoSRules(ctx context.Context,
+	deviceName string, organizationName string, policyName string) ([]DevAppQoSRule, error) {
+
+	return vDevOrgObjectGetAll[DevAppQoSRule](ctx, c, deviceName, organizationName,
+		vmsDirectorAppQoSRules(policyName))
+}
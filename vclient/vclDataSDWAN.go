@@ -0,0 +1,37 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationSLAProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevSLAProfile, error) {
+
+	return vDevOrgObjectGet[DevSLAProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorSLAProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationForwardingProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevForwardingProfile, error) {
+
+	return vDevOrgObjectGet[DevForwardingProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorForwardingProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationSDWANRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	name string) (*DevSDWANRule, error) {
+
+	return vDevOrgObjectGet[DevSDWANRule](ctx, c, deviceName, organizationName,
+		vmsDirectorSDWANRules(policyName), name)
+}
+
+/*
+ * Get rules of an SD-WAN policy in evaluation order.
+ */
+func (c *Client) GetDeviceOrganizationSDWANRules(ctx context.Context,
+	deviceName string, organizationName string, policyName string) ([]DevSDWANRule, error) {
+
+	return vDevOrgObjectGetAll[DevSDWANRule](ctx, c, deviceName, organizationName,
+		vmsDirectorSDWANRules(policyName))
+}
@@ -0,0 +1,19 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationSchedule(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectSchedule, error) {
+
+	return vDevOrgObjectGet[DevObjectSchedule](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsSchedules, name)
+}
+
+func (c *Client) GetDeviceOrganizationSchedules(ctx context.Context,
+	deviceName string, organizationName string) ([]DevObjectSchedule, error) {
+
+	return vDevOrgObjectGetAll[DevObjectSchedule](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsSchedules)
+}
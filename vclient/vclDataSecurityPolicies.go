@@ -0,0 +1,30 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationSecurityPolicy(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevSecurityPolicy, error) {
+
+	return vDevOrgObjectGet[DevSecurityPolicy](ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityPolicies, name)
+}
+
+func (c *Client) GetDeviceOrganizationSecurityRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	name string) (*DevSecurityRule, error) {
+
+	return vDevOrgObjectGet[DevSecurityRule](ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityRules(policyName), name)
+}
+
+/*
+ * Get rules of a policy in evaluation order.
+ */
+func (c *Client) GetDeviceOrganizationSecurityRules(ctx context.Context,
+	deviceName string, organizationName string, policyName string) ([]DevSecurityRule, error) {
+
+	return vDevOrgObjectGetAll[DevSecurityRule](ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityRules(policyName))
+}
@@ -0,0 +1,33 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationURLFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevURLFilteringProfile, error) {
+
+	return vDevOrgObjectGet[DevURLFilteringProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorURLFilteringProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationIPSProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevIPSProfile, error) {
+
+	return vDevOrgObjectGet[DevIPSProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorIPSProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationAntivirusProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevAntivirusProfile, error) {
+
+	return vDevOrgObjectGet[DevAntivirusProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorAntivirusProfiles, name)
+}
+
+func (c *Client) GetDeviceOrganizationFileFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevFileFilteringProfile, error) {
+
+	return vDevOrgObjectGet[DevFileFilteringProfile](ctx, c, deviceName, organizationName,
+		vmsDirectorFileFilteringProfiles, name)
+}
@@ -0,0 +1,33 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationService(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectService, error) {
+
+	return vDevOrgObjectGet[DevObjectService](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServices, name)
+}
+
+func (c *Client) GetDeviceOrganizationServices(ctx context.Context,
+	deviceName string, organizationName string) ([]DevObjectService, error) {
+
+	return vDevOrgObjectGetAll[DevObjectService](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServices)
+}
+
+func (c *Client) GetDeviceOrganizationServiceGroup(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectServiceGroup, error) {
+
+	return vDevOrgObjectGet[DevObjectServiceGroup](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServiceGroups, name)
+}
+
+func (c *Client) GetDeviceOrganizationServiceGroups(ctx context.Context,
+	deviceName string, organizationName string) ([]DevObjectServiceGroup, error) {
+
+	return vDevOrgObjectGetAll[DevObjectServiceGroup](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServiceGroups)
+}
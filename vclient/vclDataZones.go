@@ -0,0 +1,19 @@
+package vclient
+
+import (
+	"context"
+)
+
+func (c *Client) GetDeviceOrganizationZone(ctx context.Context,
+	deviceName string, organizationName string, name string) (*DevObjectZone, error) {
+
+	return vDevOrgObjectGet[DevObjectZone](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsZones, name)
+}
+
+func (c *Client) GetDeviceOrganizationZones(ctx context.Context,
+	deviceName string, organizationName string) ([]DevObjectZone, error) {
+
+	return vDevOrgObjectGetAll[DevObjectZone](ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsZones)
+}
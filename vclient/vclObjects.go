@@ -0,0 +1,190 @@
+package vclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/*
+ * Location of a list of org-services objects, e.g. objects/address-groups
+ * holding its entries under "group". Director wraps entries in the list
+ * name both in requests and responses: {"group": [{...}]}.
+ */
+type vDevOrgObjectList struct {
+	path []string
+	list string
+}
+
+/*
+ * Build url of the list, or of a single entry when name is given, e.g.
+ * .../org-services/<org>/objects/address-groups/group/<name>
+ */
+func (c *Client) vDevOrgObjectURL(deviceName string, organizationName string,
+	objList vDevOrgObjectList, name ...string) string {
+
+	segments := append(objList.escapedPath(), vPathSegment(objList.list))
+	for _, val := range name {
+		segments = append(segments, vPathSegment(val))
+	}
+	return c.vDevOrgServicesURL(deviceName, organizationName, segments...)
+}
+
+/*
+ * Path of the list container with every segment escaped, as the path
+ * holds names such as the policy of rules.
+ */
+func (l vDevOrgObjectList) escapedPath() []string {
+	var segments []string
+	for _, val := range l.path {
+		segments = append(segments, vPathSegment(val))
+	}
+	return segments
+}
+
+/* Insert positions of entries in ordered lists such as policy rules */
+const (
+	PositionFirst  = "first"
+	PositionLast   = "last"
+	PositionBefore = "before"
+	PositionAfter  = "after"
+)
+
+/*
+ * Position of a new entry in an ordered list. Insert is one of the
+ * Position constants, Point names the entry to insert before or after.
+ * Zero value appends to the list.
+ */
+type ObjectPosition struct {
+	Insert string
+	Point  string
+}
+
+/*
+ * Create an entry. POST goes to the list container with entry wrapped in
+ * the list name, director answers 409 if the entry already exists.
+ */
+func vDevOrgObjectCreate[T any](ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList,
+	name string, obj T) error {
+
+	return vDevOrgObjectCreateAt(ctx, c, deviceName, organizationName, objList,
+		name, obj, ObjectPosition{})
+}
+
+/*
+ * Create an entry of an ordered list at position. Director takes the
+ * position as insert query parameter, with the path of the reference
+ * entry in resource parameter for before and after.
+ */
+func vDevOrgObjectCreateAt[T any](ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList,
+	name string, obj T, position ObjectPosition) error {
+
+	var urlData url.Values
+	if len(position.Insert) > 0 {
+		urlData = url.Values{"insert": {position.Insert}}
+	}
+	if position.Insert == PositionBefore || position.Insert == PositionAfter {
+		point, err := url.Parse(c.vDevOrgObjectURL(deviceName, organizationName,
+			objList, position.Point))
+		if err != nil {
+			return fmt.Errorf("%v %v: %w", objList.list, name, err)
+		}
+		urlData.Set("resource", point.EscapedPath())
+	}
+
+	httpUrl := c.vDevOrgServicesURL(deviceName, organizationName, objList.escapedPath()...)
+	jsonData, err := json.Marshal(map[string][]T{objList.list: {obj}})
+	if err != nil {
+		tflog.Error(ctx, "POST "+objList.list+" "+name+" failed, json marshal error: "+err.Error())
+		return fmt.Errorf("%v %v: %w", objList.list, name, err)
+	}
+	if _, err := c.vHttpHandlePostReq(ctx, httpUrl, jsonData, urlData); err != nil {
+		tflog.Error(ctx, "POST "+objList.list+" request failed for URL: "+httpUrl+" Error: "+err.Error())
+		return fmt.Errorf("%v %v: %w", objList.list, name, err)
+	}
+	return nil
+}
+
+/*
+ * Get a single entry by name. Director answers 404 when the entry,
+ * organization or device doesn't exist, check with IsNotFound.
+ */
+func vDevOrgObjectGet[T any](ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList,
+	name string) (*T, error) {
+
+	httpUrl := c.vDevOrgObjectURL(deviceName, organizationName, objList, name)
+	tflog.Debug(ctx, "CLIENT-DATA GET URL: "+httpUrl)
+
+	var data map[string][]T
+	if err := c.vHttpHandleGetReq(ctx, httpUrl, nil, &data); err != nil {
+		return nil, err
+	}
+	if entries := data[objList.list]; len(entries) > 0 {
+		return &entries[0], nil
+	}
+	return nil, &APIError{Method: http.MethodGet, URL: httpUrl,
+		StatusCode: http.StatusNotFound, Status: "404 Not Found",
+		Message: objList.list + " " + name + " not found"}
+}
+
+/*
+ * Get all entries of the list. Director answers 404 when there are none
+ * configured yet, that's reported as an empty list.
+ */
+func vDevOrgObjectGetAll[T any](ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList) ([]T, error) {
+
+	httpUrl := c.vDevOrgObjectURL(deviceName, organizationName, objList)
+	tflog.Debug(ctx, "CLIENT-DATA GET URL: "+httpUrl)
+
+	var data map[string][]T
+	if err := c.vHttpHandleGetReq(ctx, httpUrl, nil, &data); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data[objList.list], nil
+}
+
+/*
+ * Replace an entry, attributes not present in obj are removed.
+ */
+func vDevOrgObjectUpdate[T any](ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList,
+	name string, obj T) error {
+
+	httpUrl := c.vDevOrgObjectURL(deviceName, organizationName, objList, name)
+	jsonData, err := json.Marshal(map[string][]T{objList.list: {obj}})
+	if err != nil {
+		tflog.Error(ctx, "PUT "+objList.list+" "+name+" failed, json marshal error: "+err.Error())
+		return fmt.Errorf("%v %v: %w", objList.list, name, err)
+	}
+	if _, err := c.vHttpHandlePutReq(ctx, httpUrl, jsonData, nil); err != nil {
+		tflog.Error(ctx, "PUT "+objList.list+" request failed for URL: "+httpUrl+" Error: "+err.Error())
+		return fmt.Errorf("%v %v: %w", objList.list, name, err)
+	}
+	return nil
+}
+
+/*
+ * Delete an entry by name.
+ */
+func vDevOrgObjectDelete(ctx context.Context, c *Client,
+	deviceName string, organizationName string, objList vDevOrgObjectList,
+	name string) error {
+
+	httpUrl := c.vDevOrgObjectURL(deviceName, organizationName, objList, name)
+	if _, err := c.vHttpHandleDeleteReq(ctx, httpUrl, nil, nil); err != nil {
+		tflog.Error(ctx, "DELETE "+objList.list+" request failed for URL: "+httpUrl+" Error: "+err.Error())
+		return fmt.Errorf("%v %v: %w", objList.list, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package vclient
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestObjectNamesEscaped(t *testing.T) {
+	var mu sync.Mutex
+	var paths, resources []string
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.EscapedPath())
+		resources = append(resources, r.URL.Query().Get("resource"))
+		mu.Unlock()
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := d.client(t)
+	ctx := context.Background()
+
+	prefix := "/api/config/devices/device/Branch%2F1/config/orgs/org-services/50%25"
+	tests := []struct {
+		request func() error
+		path    string
+	}{{
+		request: func() error {
+			return c.DeleteDevOrgServiceObjAddressGroup(ctx, "Branch/1", "50%", "a/b")
+		},
+		path: prefix + "/objects/address-groups/group/a%2Fb",
+	}, {
+		request: func() error {
+			return c.DeleteDevOrgServiceObjAddressGroup(ctx, "Branch/1", "50%", "../x")
+		},
+		path: prefix + "/objects/address-groups/group/..%2Fx",
+	}, {
+		request: func() error {
+			return c.DeleteDevOrgServiceObjAddressGroup(ctx, "Branch/1", "50%", "..")
+		},
+		path: prefix + "/objects/address-groups/group/%2E%2E",
+	}, {
+		request: func() error {
+			_, err := c.GetDeviceOrganizationAddress(ctx, "Branch/1", "50%", "10% off")
+			return err
+		},
+		path: prefix + "/objects/addresses/address/10%25%20off",
+	}}
+	for _, test := range tests {
+		paths = nil
+		test.request()
+		if len(paths) != 1 || paths[0] != test.path {
+			t.Errorf("got %v, want %v", paths, test.path)
+		}
+	}
+
+	paths, resources = nil, nil
+	err := c.CreateDevOrgSecurityRule(ctx, "Branch/1", "50%", "p/1", DevSecurityRule{Name: "r"},
+		ObjectPosition{Insert: PositionBefore, Point: "a/b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := prefix + "/security/access-policies/access-policy-group/p%2F1/rules"
+	if len(paths) != 1 || paths[0] != path {
+		t.Errorf("got %v, want %v", paths, path)
+	}
+	if resource := path + "/access-policy/a%2Fb"; resources[0] != resource {
+		t.Errorf("got resource %v, want %v", resources[0], resource)
+	}
+}
@@ -2,8 +2,6 @@ package vclient
 
 import (
 	"context"
-	"encoding/json"
-	"log"
 	"net/url"
 	"strconv"
 )
@@ -42,28 +40,27 @@ type VmsDirectorOrganization struct {
 	BlockInterRegionRouting bool     `json:"blockInterRegionRouting"`
 }
 
+/*
+ * Get all organizations from director, walking every page of the list.
+ * Organization API doesn't report total count, so pages are requested
+ * until director returns a short or repeated one.
+ */
 func (c *Client) GetAllOrganizations(ctx context.Context) ([]VmsDirectorOrganization, error) {
 
-	client, apiUrl, err := vHttpClient(c.Config.ServerIP, c.Config.ServerPort, vmsDirectorOrganizationsURL)
-	if err != nil {
-		log.Printf("Unable to create http-client %v\n", err)
-		return nil, err
-	}
+	apiUrl := c.vApiURL(vmsDirectorOrganizationsURL)
 
-	urlData := url.Values{}
-	urlData.Set("limit", strconv.Itoa(10))
-	urlData.Add("offset", strconv.Itoa(0))
-	urlData.Add("uuidOnly", "false")
-
-	if data, err := c.vHttpHandleGetReq(ctx, client, apiUrl, urlData); err != nil {
-		return nil, err
-	} else {
-		vDisplayResponse(data)
+	fetch := func(ctx context.Context, offset int, limit int) ([]VmsDirectorOrganization, int, error) {
+		urlData := url.Values{}
+		urlData.Set("limit", strconv.Itoa(limit))
+		urlData.Add("offset", strconv.Itoa(offset))
+		urlData.Add("uuidOnly", "false")
 
 		organizationsData := []VmsDirectorOrganization{}
-		if err := json.Unmarshal([]byte(data), &organizationsData); err != nil {
-			return nil, err
+		if err := c.vHttpHandleGetReq(ctx, apiUrl, urlData, &organizationsData); err != nil {
+			return nil, -1, err
 		}
-		return organizationsData, nil
+		return organizationsData, -1, nil
 	}
+
+	return vPaginate(ctx, c.Pagination, fetch)
 }
@@ -0,0 +1,176 @@
+package vclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+/* default pagination parameters used when provider doesn't override them */
+const (
+	vDefaultPageSize        = 50
+	vDefaultPageConcurrency = 4
+)
+
+/*
+ * Upper bound of pages fetched for one list when director doesn't report
+ * total count, guards against looping forever if offset is ignored.
+ */
+const vMaxPages = 10000
+
+/*
+ * Pagination parameters for list APIs on director. PageSize is the limit
+ * sent with each request and Concurrency is the maximum number of pages
+ * fetched in parallel once total count of objects is known.
+ */
+type PaginationConfig struct {
+	PageSize    int
+	Concurrency int
+}
+
+func (p PaginationConfig) pageSize() int {
+	if p.PageSize <= 0 {
+		return vDefaultPageSize
+	}
+	return p.PageSize
+}
+
+func (p PaginationConfig) concurrency() int {
+	if p.Concurrency <= 0 {
+		return vDefaultPageConcurrency
+	}
+	return p.Concurrency
+}
+
+/*
+ * Fetches one page of objects starting at offset. Total count of objects
+ * is returned when director reports it, otherwise -1.
+ */
+type vPageFetcher[T any] func(ctx context.Context, offset int, limit int) ([]T, int, error)
+
+/*
+ * Walk all pages of a list API. First page is always fetched alone; if
+ * director reported total count the remaining pages are fetched in
+ * parallel, otherwise offset is advanced sequentially until a page is
+ * shorter than the first one or repeats the previous one. Parallel pages
+ * not adding up to the total count of the first page mean the list
+ * changed while it was read, it is then read again sequentially.
+ */
+func vPaginate[T any](ctx context.Context, config PaginationConfig,
+	fetch vPageFetcher[T]) ([]T, error) {
+
+	limit := config.pageSize()
+
+	items, total, err := fetch(ctx, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	if total < 0 {
+		return vPaginateSequential(ctx, limit, items, fetch)
+	}
+
+	/* director may cap the limit, step by what was actually returned */
+	step := len(items)
+	var offsets []int
+	for offset := step; offset < total; offset += step {
+		offsets = append(offsets, offset)
+	}
+	if len(offsets) == 0 {
+		return items, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]T, len(offsets))
+	totals := make([]int, len(offsets))
+	var fetchErr error
+	var errOnce sync.Once
+	sem := make(chan struct{}, config.concurrency())
+	var wg sync.WaitGroup
+	for idx, offset := range offsets {
+		wg.Add(1)
+		go func(idx int, offset int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if ctx.Err() != nil {
+				return
+			}
+			page, pageTotal, err := fetch(ctx, offset, step)
+			if err != nil {
+				/* keep the first failure, later ones are due to cancel */
+				errOnce.Do(func() {
+					fetchErr = err
+					cancel()
+				})
+				return
+			}
+			pages[idx] = page
+			totals[idx] = pageTotal
+		}(idx, offset)
+	}
+	wg.Wait()
+
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	changed := false
+	for idx := range offsets {
+		changed = changed || totals[idx] != total
+		items = append(items, pages[idx]...)
+	}
+	if !changed && len(items) == total {
+		return items, nil
+	}
+
+	/*
+	 * Objects were added or removed while paging, so pages overlap or
+	 * leave gaps. Sequential walk reads the list as it is now.
+	 */
+	items, _, err = fetch(ctx, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return items, nil
+	}
+	return vPaginateSequential(ctx, limit, items, fetch)
+}
+
+/*
+ * Fetch pages one after another following first page. Director may cap
+ * the limit, so a page shorter than the first one ends the list. A page
+ * equal to the previous one means offset isn't honoured and ends it too.
+ */
+func vPaginateSequential[T any](ctx context.Context, limit int, items []T,
+	fetch vPageFetcher[T]) ([]T, error) {
+
+	step := len(items)
+	prev := items
+	for pages := 1; ; pages++ {
+		if pages >= vMaxPages {
+			return nil, fmt.Errorf("list exceeds %v pages of %v objects", vMaxPages, step)
+		}
+		page, _, err := fetch(ctx, len(items), limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 || reflect.DeepEqual(page, prev) {
+			return items, nil
+		}
+		items = append(items, page...)
+		if len(page) < step {
+			return items, nil
+		}
+		prev = page
+	}
+}
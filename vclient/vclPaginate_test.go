@@ -0,0 +1,222 @@
+package vclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+/* organizations org-0 to org-<count-1> */
+func testOrganizations(count int) []VmsDirectorOrganization {
+	orgs := make([]VmsDirectorOrganization, count)
+	for idx := range orgs {
+		orgs[idx].Name = "org-" + strconv.Itoa(idx)
+	}
+	return orgs
+}
+
+/* page of list for offset and limit query parameters, capped at maxLimit */
+func testPage[T any](r *http.Request, list []T, maxLimit int) []T {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if maxLimit > 0 && limit > maxLimit {
+		limit = maxLimit
+	}
+	if offset >= len(list) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(list) {
+		end = len(list)
+	}
+	return list[offset:end]
+}
+
+func TestPaginateSequential(t *testing.T) {
+	tests := map[string]struct {
+		count    int
+		pageSize int
+		maxLimit int
+		requests int32
+	}{
+		"short last page":    {count: 7, pageSize: 3, requests: 3},
+		"empty last page":    {count: 6, pageSize: 3, requests: 3},
+		"single page":        {count: 2, pageSize: 3, requests: 2},
+		"limit capped":       {count: 5, pageSize: 10, maxLimit: 2, requests: 3},
+		"limit capped exact": {count: 4, pageSize: 10, maxLimit: 2, requests: 3},
+		"empty list":         {count: 0, pageSize: 3, requests: 1},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			orgs := testOrganizations(test.count)
+			var requests atomic.Int32
+			d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				json.NewEncoder(w).Encode(testPage(r, orgs, test.maxLimit))
+			})
+			c := d.client(t)
+			c.Pagination.PageSize = test.pageSize
+
+			list, err := c.GetAllOrganizations(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list) != test.count {
+				t.Errorf("got %v organizations, want %v", len(list), test.count)
+			}
+			for idx, val := range list {
+				if val.Name != orgs[idx].Name {
+					t.Errorf("organization %v is %v, want %v", idx, val.Name, orgs[idx].Name)
+				}
+			}
+			if got := requests.Load(); got != test.requests {
+				t.Errorf("got %v requests, want %v", got, test.requests)
+			}
+		})
+	}
+}
+
+func TestPaginateSequentialOffsetIgnored(t *testing.T) {
+	orgs := testOrganizations(3)
+	var requests atomic.Int32
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		json.NewEncoder(w).Encode(orgs)
+	})
+	c := d.client(t)
+	c.Pagination.PageSize = 3
+
+	list, err := c.GetAllOrganizations(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != len(orgs) {
+		t.Errorf("got %v organizations, want %v", len(list), len(orgs))
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %v requests, want 2", got)
+	}
+}
+
+func TestPaginateSequentialPageLimit(t *testing.T) {
+	var requests atomic.Int32
+	fetch := func(ctx context.Context, offset int, limit int) ([]int, int, error) {
+		requests.Add(1)
+		return []int{offset}, -1, nil
+	}
+
+	_, err := vPaginate(context.Background(), PaginationConfig{PageSize: 1}, fetch)
+	if err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Fatalf("got error %v, want page limit error", err)
+	}
+	if got := requests.Load(); got != vMaxPages {
+		t.Errorf("got %v requests, want %v", got, vMaxPages)
+	}
+}
+
+/* appliances list reporting total count, as director does */
+func testAppliancesHandler(appliances []VmsDirectorAppliance, maxLimit int,
+	requests *atomic.Int32) http.HandlerFunc {
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		json.NewEncoder(w).Encode(VmsDirectorAppliances{
+			TotalCount: len(appliances),
+			Appliances: testPage(r, appliances, maxLimit),
+		})
+	}
+}
+
+func TestPaginateConcurrent(t *testing.T) {
+	appliances := make([]VmsDirectorAppliance, 11)
+	for idx := range appliances {
+		appliances[idx].Name = "branch-" + strconv.Itoa(idx)
+	}
+	var requests atomic.Int32
+	d := newTestDirector(t, testAppliancesHandler(appliances, 3, &requests))
+	c := d.client(t)
+	c.Pagination = PaginationConfig{PageSize: 5, Concurrency: 2}
+
+	list, err := c.GetAllAppliances(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.TotalCount != len(appliances) || len(list.Appliances) != len(appliances) {
+		t.Fatalf("got %v appliances, want %v", len(list.Appliances), len(appliances))
+	}
+	for idx, val := range list.Appliances {
+		if val.Name != appliances[idx].Name {
+			t.Errorf("appliance %v is %v, want %v", idx, val.Name, appliances[idx].Name)
+		}
+	}
+	/* limit capped at 3 by director, 4 pages of 3 */
+	if got := requests.Load(); got != 4 {
+		t.Errorf("got %v requests, want 4", got)
+	}
+}
+
+func TestPaginateConcurrentListChanged(t *testing.T) {
+	names := func(count int, removed int) []VmsDirectorAppliance {
+		var appliances []VmsDirectorAppliance
+		for idx := 0; idx < count; idx++ {
+			if idx != removed {
+				appliances = append(appliances, VmsDirectorAppliance{Name: "branch-" + strconv.Itoa(idx)})
+			}
+		}
+		return appliances
+	}
+	tests := map[string]struct {
+		before []VmsDirectorAppliance
+		after  []VmsDirectorAppliance
+		total  int
+	}{
+		/* later pages shift, reported total shrinks */
+		"removed": {before: names(9, -1), after: names(9, 1)},
+		/* later pages shift, reported total grows */
+		"added": {before: names(9, -1), after: names(10, -1)},
+		/* total still as on first page, middle page short */
+		"short page": {before: names(9, -1), after: names(9, 4), total: 9},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+				/* list changes once the first page was read */
+				appliances := test.after
+				if requests.Add(1) == 1 {
+					appliances = test.before
+				}
+				total := len(appliances)
+				if test.total > 0 {
+					total = test.total
+				}
+				json.NewEncoder(w).Encode(VmsDirectorAppliances{
+					TotalCount: total,
+					Appliances: testPage(r, appliances, 0),
+				})
+			})
+			c := d.client(t)
+			c.Pagination.PageSize = 3
+
+			list, err := c.GetAllAppliances(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, val := range list.Appliances {
+				got = append(got, val.Name)
+			}
+			var want []string
+			for _, val := range test.after {
+				want = append(want, val.Name)
+			}
+			if strings.Join(got, " ") != strings.Join(want, " ") {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/objects/address-groups/group/<name>
+var vmsDirectorObjectsAddressGroups = vDevOrgObjectList{
+	path: []string{"objects", "address-groups"},
+	list: "group",
+}
+
+/*
+ * Address group of an organization. Members are referenced by name:
+ * address objects, other address groups and address files.
+ */
+type DevObjectAddressGroup struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Tags          []string `json:"tag,omitempty"`
+	Addresses     []string `json:"address-list,omitempty"`
+	AddressGroups []string `json:"address-group-list,omitempty"`
+	AddressFiles  []string `json:"address-files,omitempty"`
+}
+
+func (c *Client) CreateDevOrgServiceObjAddressGroup(ctx context.Context,
+	deviceName string, organizationName string, group DevObjectAddressGroup) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsAddressGroups, group.Name, group)
+}
+
+func (c *Client) UpdateDevOrgServiceObjAddressGroup(ctx context.Context,
+	deviceName string, organizationName string, group DevObjectAddressGroup) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsAddressGroups, group.Name, group)
+}
+
+func (c *Client) DeleteDevOrgServiceObjAddressGroup(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsAddressGroups, name)
+}
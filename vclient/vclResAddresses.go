@@ -17,9 +17,49 @@ const (
 	vmsDirectorObjectsAddressesURL = "objects/addresses"
 )
 
+/*
+ * Build url of org-services objects of a device, e.g.
+ * api/config/devices/device/<dev>/config/orgs/org-services/<org>/objects/addresses
+ * Device and org names are escaped, objectPath is used as given.
+ */
+func (c *Client) vDevOrgServicesURL(deviceName string, organizationName string,
+	objectPath ...string) string {
+
+	segments := []string{vmsDirectorDevicesURL, vPathSegment(deviceName),
+		vmsDirectorOrgServicesURL, vPathSegment(organizationName)}
+	return c.vApiURL(append(segments, objectPath...)...)
+}
+
+/*
+ * Address object of an organization. Only one of FQDN, IPv4Prefix,
+ * IPv6Prefix, IPv4Range, IPv4WildcardMask and DynamicAddress is set.
+ */
 type DevObjectAddress struct {
-	Name string `json:"name"`
-	FQDN string `json:"fqdn,optional"`
+	Name             string                    `json:"name"`
+	Description      string                    `json:"description,omitempty"`
+	Tags             []string                  `json:"tag,omitempty"`
+	FQDN             string                    `json:"fqdn,omitempty"`
+	IPv4Prefix       string                    `json:"ipv4-prefix,omitempty"`
+	IPv6Prefix       string                    `json:"ipv6-prefix,omitempty"`
+	IPv4Range        *DevObjectAddressRange    `json:"ipv4-range,omitempty"`
+	IPv4WildcardMask *DevObjectAddressWildcard `json:"ipv4-wildcard-mask,omitempty"`
+	DynamicAddress   string                    `json:"dynamic-address,omitempty"`
+}
+
+/*
+ * IPv4 address range, start and end addresses are inclusive.
+ */
+type DevObjectAddressRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+/*
+ * IPv4 address matched with wildcard mask, e.g. 10.0.1.0 0.0.255.255.
+ */
+type DevObjectAddressWildcard struct {
+	Address string `json:"address"`
+	Mask    string `json:"mask"`
 }
 
 type DevObjectsAddressList struct {
@@ -48,13 +88,8 @@ func (c *Client) CreateDevOrgServiceObjAddresses(ctx context.Context,
 		tflog.Trace(ctx, "Address["+strconv.Itoa(key)+"]: Name "+val.Name+" FQDN "+val.FQDN)
 	}
 
-	client, _, _ := vHttpClient(c.Config.ServerIP, c.Config.ServerPort, "")
-	httpUrl := "https://" + c.Config.ServerIP + ":" + strconv.Itoa(c.Config.ServerPort) + "/" +
-		vmsDirectorDevicesURL + "/" +
-		addrList.DeviceName + "/" +
-		vmsDirectorOrgServicesURL + "/" +
-		addrList.OrganizationName + "/" +
-		vmsDirectorObjectsAddressesURL
+	httpUrl := c.vDevOrgServicesURL(addrList.DeviceName, addrList.OrganizationName,
+		vmsDirectorObjectsAddressesURL)
 
 	jsonData, err := json.Marshal(addrList)
 	if err != nil {
@@ -62,7 +97,7 @@ func (c *Client) CreateDevOrgServiceObjAddresses(ctx context.Context,
 		return err
 	}
 
-	if _, err := c.vHttpHandlePostReq(ctx, client, httpUrl, jsonData, nil); err != nil {
+	if _, err := c.vHttpHandlePostReq(ctx, httpUrl, jsonData, nil); err != nil {
 		tflog.Error(ctx, "POST Addresses request failed for URL: "+httpUrl+" Error: "+err.Error())
 		return err
 	}
@@ -70,14 +105,18 @@ func (c *Client) CreateDevOrgServiceObjAddresses(ctx context.Context,
 	return err
 }
 
+/*
+ * Update addresses one by one. Addresses accepted by director before a
+ * failure are returned along with the error so caller can record them.
+ */
 func (c *Client) UpdateDevOrgServiceObjAddresses(ctx context.Context,
-	addrListData DevOjectsAddressListData) error {
+	addrListData DevOjectsAddressListData) ([]DevObjectAddress, error) {
 
 	addrList := addrListData.AddrList
 
 	if addrList.Count <= 0 || len(addrList.Addresses) <= 0 {
 		tflog.Trace(ctx, "PUT addresses request failed as address count is 0")
-		return errors.New("Device Orgs Address update failed as addresses count is 0")
+		return nil, errors.New("Device Orgs Address update failed as addresses count is 0")
 	}
 
 	tflog.Trace(ctx, "Device-Name "+addrList.DeviceName+" OrgName "+addrList.OrganizationName)
@@ -85,41 +124,40 @@ func (c *Client) UpdateDevOrgServiceObjAddresses(ctx context.Context,
 		tflog.Trace(ctx, "Address["+strconv.Itoa(key)+"]: Name "+val.Name+" FQDN "+val.FQDN)
 	}
 
-	client, _, _ := vHttpClient(c.Config.ServerIP, c.Config.ServerPort, "")
-	httpUrl := "https://" + c.Config.ServerIP + ":" + strconv.Itoa(c.Config.ServerPort) + "/" +
-		vmsDirectorDevicesURL + "/" +
-		addrList.DeviceName + "/" +
-		vmsDirectorOrgServicesURL + "/" +
-		addrList.OrganizationName + "/" +
-		vmsDirectorObjectsAddressesURL + "/" +
-		"address" + "/"
-
 	// Modify expects individual objects, send one after another
+	var updated []DevObjectAddress
 	for _, val := range addrList.Addresses {
-		curHttpUrl := httpUrl + val.Name
+		curHttpUrl := c.vDevOrgServicesURL(addrList.DeviceName, addrList.OrganizationName,
+			vmsDirectorObjectsAddressesURL, "address", vPathSegment(val.Name))
 		var curAddrData DevObjectsAddressList
 		curAddrData.Addresses = append(curAddrData.Addresses, val)
 		if jsonData, err := json.Marshal(curAddrData); err != nil {
 			tflog.Error(ctx, "PUT Addresses request failed for "+val.Name+" Error: "+err.Error())
-			return err
+			return updated, fmt.Errorf("address %v: %w", val.Name, err)
 		} else {
-			if _, err := c.vHttpHandlePutReq(ctx, client, curHttpUrl, jsonData, nil); err != nil {
+			if _, err := c.vHttpHandlePutReq(ctx, curHttpUrl, jsonData, nil); err != nil {
 				tflog.Error(ctx, "PUT Addresses request failed, error: "+err.Error())
-				return err
+				return updated, fmt.Errorf("address %v: %w", val.Name, err)
 			}
 		}
+		updated = append(updated, val)
 	}
-	return nil
+	return updated, nil
 }
 
+/*
+ * Delete addresses one by one. Addresses removed from director before a
+ * failure are returned along with the error so caller can record them.
+ * Addresses already missing on director count as deleted.
+ */
 func (c *Client) DeleteDevOrgServiceObjAddresses(ctx context.Context,
-	addrListData DevOjectsAddressListData) error {
+	addrListData DevOjectsAddressListData) ([]DevObjectAddress, error) {
 
 	addrList := addrListData.AddrList
 
 	if addrList.Count <= 0 || len(addrList.Addresses) <= 0 {
 		tflog.Trace(ctx, "Device Orgs Address deletion failed as addresses count is 0")
-		return errors.New("Device Orgs Address deletion failed as addresses count is 0")
+		return nil, errors.New("Device Orgs Address deletion failed as addresses count is 0")
 	}
 
 	tflog.Trace(ctx, "Device-Name "+addrList.DeviceName+" OrgName "+addrList.OrganizationName)
@@ -127,30 +165,98 @@ func (c *Client) DeleteDevOrgServiceObjAddresses(ctx context.Context,
 		tflog.Trace(ctx, "Address["+strconv.Itoa(key)+"]: Name "+val.Name+" FQDN "+val.FQDN)
 	}
 
-	client, _, _ := vHttpClient(c.Config.ServerIP, c.Config.ServerPort, "")
-	httpUrl := "https://" + c.Config.ServerIP + ":" + strconv.Itoa(c.Config.ServerPort) + "/" +
-		vmsDirectorDevicesURL + "/" +
-		addrList.DeviceName + "/" +
-		vmsDirectorOrgServicesURL + "/" +
-		addrList.OrganizationName + "/" +
-		vmsDirectorObjectsAddressesURL + "/" +
-		"address" + "/"
-
 	// Delete expects individual objects, send one after another
+	var deleted []DevObjectAddress
 	for _, val := range addrList.Addresses {
-		curHttpUrl := httpUrl + val.Name
+		curHttpUrl := c.vDevOrgServicesURL(addrList.DeviceName, addrList.OrganizationName,
+			vmsDirectorObjectsAddressesURL, "address", vPathSegment(val.Name))
 		var curAddrData DevObjectsAddressList
 		curAddrData.Addresses = append(curAddrData.Addresses, val)
 		if jsonData, err := json.Marshal(curAddrData); err != nil {
 			tflog.Error(ctx, "Address Delete failed for "+val.Name+" Error: "+err.Error())
-			return err
+			return deleted, fmt.Errorf("address %v: %w", val.Name, err)
 		} else {
-			if _, err := c.vHttpHandleDeleteReq(ctx, client, curHttpUrl, jsonData, nil); err != nil {
-				fmt.Printf("DELETE Addresses request failed, error %v\n", err)
+			if _, err := c.vHttpHandleDeleteReq(ctx, curHttpUrl, jsonData, nil); IsNotFound(err) {
+				tflog.Debug(ctx, "Address "+val.Name+" already deleted")
+			} else if err != nil {
 				tflog.Error(ctx, "DELETE Addresses request failed, error: "+err.Error())
-				return err
+				return deleted, fmt.Errorf("address %v: %w", val.Name, err)
 			}
 		}
+		deleted = append(deleted, val)
 	}
-	return nil
+	return deleted, nil
+}
+
+/*
+ * Address changes of a device organization, computed by the caller from
+ * prior and planned address lists.
+ */
+type DevObjectsAddressDiff struct {
+	Added   []DevObjectAddress
+	Changed []DevObjectAddress
+	Removed []DevObjectAddress
+}
+
+/* reports whether there is nothing to apply */
+func (d DevObjectsAddressDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+/*
+ * Apply address changes: removed addresses are deleted, changed ones are
+ * replaced and added ones created. Director doesn't offer a transaction
+ * spanning several org-services objects, so additions are batched into a
+ * single POST which director applies all or nothing, while changes and
+ * removals go per object. Changes applied before a failure are returned
+ * along with the error so caller can record them.
+ */
+func (c *Client) ApplyDevOrgServiceObjAddresses(ctx context.Context,
+	deviceName string, organizationName string,
+	diff DevObjectsAddressDiff) (DevObjectsAddressDiff, error) {
+
+	var applied DevObjectsAddressDiff
+	var err error
+
+	tflog.Trace(ctx, "Device-Name "+deviceName+" OrgName "+organizationName+
+		" add "+strconv.Itoa(len(diff.Added))+" change "+strconv.Itoa(len(diff.Changed))+
+		" remove "+strconv.Itoa(len(diff.Removed)))
+
+	// Delete first so names can be reused by additions
+	if len(diff.Removed) > 0 {
+		applied.Removed, err = c.DeleteDevOrgServiceObjAddresses(ctx,
+			vDevObjectsAddressListData(deviceName, organizationName, diff.Removed))
+		if err != nil {
+			return applied, err
+		}
+	}
+
+	if len(diff.Changed) > 0 {
+		applied.Changed, err = c.UpdateDevOrgServiceObjAddresses(ctx,
+			vDevObjectsAddressListData(deviceName, organizationName, diff.Changed))
+		if err != nil {
+			return applied, err
+		}
+	}
+
+	if len(diff.Added) > 0 {
+		err = c.CreateDevOrgServiceObjAddresses(ctx,
+			vDevObjectsAddressListData(deviceName, organizationName, diff.Added))
+		if err != nil {
+			return applied, err
+		}
+		applied.Added = diff.Added
+	}
+	return applied, nil
+}
+
+func vDevObjectsAddressListData(deviceName string, organizationName string,
+	addresses []DevObjectAddress) DevOjectsAddressListData {
+
+	return DevOjectsAddressListData{AddrList: DevObjectsAddressList{
+		DeviceName:       deviceName,
+		OrganizationName: organizationName,
+		Count:            len(addresses),
+		Addresses:        addresses,
+	}}
 }
@@ -0,0 +1,41 @@
+package vclient
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestDeleteAddressesMissing(t *testing.T) {
+	var mu sync.Mutex
+	var requested []string
+	d := newTestDirector(t, func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		mu.Lock()
+		requested = append(requested, name)
+		mu.Unlock()
+		if name == "deleted" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	c := d.client(t)
+
+	addresses := []DevObjectAddress{{Name: "first"}, {Name: "deleted"}, {Name: "last"}}
+	deleted, err := c.DeleteDevOrgServiceObjAddresses(context.Background(),
+		DevOjectsAddressListData{AddrList: DevObjectsAddressList{
+			DeviceName:       "Branch-1",
+			OrganizationName: "Customer-1",
+			Count:            len(addresses),
+			Addresses:        addresses,
+		}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deleted) != len(addresses) || len(requested) != len(addresses) {
+		t.Errorf("got %v deleted with %v requests, want %v", len(deleted), len(requested), len(addresses))
+	}
+}
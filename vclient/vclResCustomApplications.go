@@ -0,0 +1,110 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/application-identification/user-defined-applications/user-defined-application/<name>
+var vmsDirectorCustomApplications = vDevOrgObjectList{
+	path: []string{"application-identification", "user-defined-applications"},
+	list: "user-defined-application",
+}
+
+// .../org-services/<org>/url-filtering/user-defined-urlcategories/url-category/<name>
+var vmsDirectorCustomURLCategories = vDevOrgObjectList{
+	path: []string{"url-filtering", "user-defined-urlcategories"},
+	list: "url-category",
+}
+
+/*
+ * User defined application of an organization, identified by the match
+ * rules. Traffic matching any rule is classified as the application.
+ */
+type DevCustomApplication struct {
+	Name         string                     `json:"app-name"`
+	Description  string                     `json:"description,omitempty"`
+	Tags         []string                   `json:"tag,omitempty"`
+	Family       string                     `json:"family,omitempty"`
+	SubFamily    string                     `json:"subfamily,omitempty"`
+	Risk         *int64                     `json:"risk,omitempty"`
+	Productivity *int64                     `json:"productivity,omitempty"`
+	Rules        []DevCustomApplicationRule `json:"app-match-rules,omitempty"`
+}
+
+/*
+ * Signature of a user defined application, all set attributes must match.
+ */
+type DevCustomApplicationRule struct {
+	Name              string `json:"rule-name"`
+	HostPattern       string `json:"host-pattern,omitempty"`
+	URLPattern        string `json:"uri-pattern,omitempty"`
+	Protocol          string `json:"protocol,omitempty"`
+	DestinationPort   string `json:"destination-port,omitempty"`
+	DestinationPrefix string `json:"destination-prefix,omitempty"`
+}
+
+/*
+ * User defined URL category of an organization. URLs match exactly,
+ * patterns are regular expressions.
+ */
+type DevCustomURLCategory struct {
+	Name        string                    `json:"category-name"`
+	Description string                    `json:"category-description,omitempty"`
+	Confidence  *int64                    `json:"confidence,omitempty"`
+	URLs        *DevCustomURLCategoryURLs `json:"urls,omitempty"`
+}
+
+type DevCustomURLCategoryURLs struct {
+	Strings  []DevCustomURLCategoryString  `json:"strings,omitempty"`
+	Patterns []DevCustomURLCategoryPattern `json:"patterns,omitempty"`
+}
+
+type DevCustomURLCategoryString struct {
+	Value string `json:"string-value"`
+}
+
+type DevCustomURLCategoryPattern struct {
+	Value string `json:"pattern-value"`
+}
+
+func (c *Client) CreateDevOrgCustomApplication(ctx context.Context,
+	deviceName string, organizationName string, app DevCustomApplication) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomApplications, app.Name, app)
+}
+
+func (c *Client) UpdateDevOrgCustomApplication(ctx context.Context,
+	deviceName string, organizationName string, app DevCustomApplication) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomApplications, app.Name, app)
+}
+
+func (c *Client) DeleteDevOrgCustomApplication(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomApplications, name)
+}
+
+func (c *Client) CreateDevOrgCustomURLCategory(ctx context.Context,
+	deviceName string, organizationName string, category DevCustomURLCategory) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomURLCategories, category.Name, category)
+}
+
+func (c *Client) UpdateDevOrgCustomURLCategory(ctx context.Context,
+	deviceName string, organizationName string, category DevCustomURLCategory) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomURLCategories, category.Name, category)
+}
+
+func (c *Client) DeleteDevOrgCustomURLCategory(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorCustomURLCategories, name)
+}
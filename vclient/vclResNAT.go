@@ -0,0 +1,115 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/cgnat/pools/pool/<name>
+var vmsDirectorNATPools = vDevOrgObjectList{
+	path: []string{"cgnat", "pools"},
+	list: "pool",
+}
+
+// NAT rules are an ordered list, e.g. .../org-services/<org>/cgnat/rules/rule/<name>
+var vmsDirectorNATRules = vDevOrgObjectList{
+	path: []string{"cgnat", "rules"},
+	list: "rule",
+}
+
+/* Translation types of NAT rules */
+const (
+	NATTranslationNAPT44 = "napt-44"
+	NATTranslationStatic = "basic-nat-44"
+	NATTranslationDNAT   = "dnat-44"
+)
+
+/*
+ * NAT pool of an organization, addresses are given as prefixes or
+ * ranges. PortRange limits ports used for NAPT translation.
+ */
+type DevNATPool struct {
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description,omitempty"`
+	Tags            []string                `json:"tag,omitempty"`
+	Prefixes        []string                `json:"address-prefix,omitempty"`
+	Ranges          []DevObjectAddressRange `json:"address-range,omitempty"`
+	PortRange       string                  `json:"port-range,omitempty"`
+	RoutingInstance string                  `json:"routing-instance,omitempty"`
+	EgressNetworks  []string                `json:"egress-network,omitempty"`
+}
+
+/*
+ * NAT rule. Source translation types use SourcePool, dnat-44 uses
+ * DestinationPool. Empty match criteria match any.
+ */
+type DevNATRule struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Tags        []string        `json:"tag,omitempty"`
+	Disabled    bool            `json:"rule-disable,omitempty"`
+	Match       DevNATRuleMatch `json:"match"`
+	Set         DevNATRuleSet   `json:"set"`
+}
+
+type DevNATRuleMatch struct {
+	SourceZones         []string `json:"source-zone-list,omitempty"`
+	SourcePrefixes      []string `json:"source-prefix,omitempty"`
+	DestinationPrefixes []string `json:"destination-prefix,omitempty"`
+	Protocol            string   `json:"protocol,omitempty"`
+	DestinationPort     string   `json:"destination-port,omitempty"`
+}
+
+type DevNATRuleSet struct {
+	TranslationType string `json:"translation-type"`
+	SourcePool      string `json:"source-pool,omitempty"`
+	DestinationPool string `json:"destination-pool,omitempty"`
+}
+
+func (c *Client) CreateDevOrgNATPool(ctx context.Context,
+	deviceName string, organizationName string, pool DevNATPool) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorNATPools, pool.Name, pool)
+}
+
+func (c *Client) UpdateDevOrgNATPool(ctx context.Context,
+	deviceName string, organizationName string, pool DevNATPool) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorNATPools, pool.Name, pool)
+}
+
+func (c *Client) DeleteDevOrgNATPool(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorNATPools, name)
+}
+
+/*
+ * Create a NAT rule at position, point of position is another NAT rule.
+ */
+func (c *Client) CreateDevOrgNATRule(ctx context.Context,
+	deviceName string, organizationName string, rule DevNATRule,
+	position ObjectPosition) error {
+
+	return vDevOrgObjectCreateAt(ctx, c, deviceName, organizationName,
+		vmsDirectorNATRules, rule.Name, rule, position)
+}
+
+/*
+ * Update a rule in place, its position in the list doesn't change.
+ */
+func (c *Client) UpdateDevOrgNATRule(ctx context.Context,
+	deviceName string, organizationName string, rule DevNATRule) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorNATRules, rule.Name, rule)
+}
+
+func (c *Client) DeleteDevOrgNATRule(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorNATRules, name)
+}
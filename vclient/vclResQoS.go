@@ -0,0 +1,146 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/class-of-service/qos-profiles/qos-profile/<name>
+var vmsDirectorQoSProfiles = vDevOrgObjectList{
+	path: []string{"class-of-service", "qos-profiles"},
+	list: "qos-profile",
+}
+
+// .../org-services/<org>/class-of-service/classifiers/classifier/<name>
+var vmsDirectorCoSClassifiers = vDevOrgObjectList{
+	path: []string{"class-of-service", "classifiers"},
+	list: "classifier",
+}
+
+/*
+ * Rules of an app-QoS policy are an ordered list below the policy, e.g.
+ * .../class-of-service/app-qos-policies/app-qos-policy-group/<policy>/rules/rule/<rule>
+ */
+func vmsDirectorAppQoSRules(policyName string) vDevOrgObjectList {
+	return vDevOrgObjectList{
+		path: []string{"class-of-service", "app-qos-policies", "app-qos-policy-group",
+			policyName, "rules"},
+		list: "rule",
+	}
+}
+
+/* Loss priorities of forwarding classes */
+const (
+	LossPriorityLow  = "low"
+	LossPriorityHigh = "high"
+)
+
+/*
+ * QoS profile applied by app-QoS rules. Traffic is shaped to PeakRate
+ * kbps and assigned forwarding class and loss priority.
+ */
+type DevQoSProfile struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Tags            []string `json:"tag,omitempty"`
+	PeakRate        *int64   `json:"peak-kbps-rate,omitempty"`
+	BurstSize       *int64   `json:"peak-burst-size,omitempty"`
+	ForwardingClass string   `json:"forwarding-class,omitempty"`
+	LossPriority    string   `json:"loss-priority,omitempty"`
+}
+
+/*
+ * Class-of-service classifier mapping DSCP values of received traffic to
+ * forwarding class and loss priority.
+ */
+type DevCoSClassifier struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description,omitempty"`
+	Mappings    []DevCoSClassifierMapping `json:"dscp,omitempty"`
+}
+
+type DevCoSClassifierMapping struct {
+	Values          []int64 `json:"values"`
+	ForwardingClass string  `json:"forwarding-class"`
+	LossPriority    string  `json:"loss-priority,omitempty"`
+}
+
+/*
+ * App-QoS policy rule, traffic matching the rule gets the QoS profile.
+ */
+type DevAppQoSRule = DevPolicyRule[DevAppQoSRuleSet]
+
+type DevAppQoSRuleSet struct {
+	QoSProfile string `json:"qos-profile"`
+}
+
+func (c *Client) CreateDevOrgQoSProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevQoSProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorQoSProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgQoSProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevQoSProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorQoSProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgQoSProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorQoSProfiles, name)
+}
+
+func (c *Client) CreateDevOrgCoSClassifier(ctx context.Context,
+	deviceName string, organizationName string, classifier DevCoSClassifier) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorCoSClassifiers, classifier.Name, classifier)
+}
+
+func (c *Client) UpdateDevOrgCoSClassifier(ctx context.Context,
+	deviceName string, organizationName string, classifier DevCoSClassifier) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorCoSClassifiers, classifier.Name, classifier)
+}
+
+func (c *Client) DeleteDevOrgCoSClassifier(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorCoSClassifiers, name)
+}
+
+/*
+ * Create a rule in app-QoS policy at position, point of position is a
+ * rule name of the same policy.
+ */
+func (c *Client) CreateDevOrgAppQoSRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevAppQoSRule, position ObjectPosition) error {
+
+	return vDevOrgObjectCreateAt(ctx, c, deviceName, organizationName,
+		vmsDirectorAppQoSRules(policyName), rule.Name, rule, position)
+}
+
+/*
+ * Update a rule in place, its position in the policy doesn't change.
+ */
+func (c *Client) UpdateDevOrgAppQoSRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevAppQoSRule) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorAppQoSRules(policyName), rule.Name, rule)
+}
+
+func (c *Client) DeleteDevOrgAppQoSRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorAppQoSRules(policyName), name)
+}
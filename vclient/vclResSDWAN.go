@@ -0,0 +1,186 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/sd-wan/sla-profiles/sla-profile/<name>
+var vmsDirectorSLAProfiles = vDevOrgObjectList{
+	path: []string{"sd-wan", "sla-profiles"},
+	list: "sla-profile",
+}
+
+// .../org-services/<org>/sd-wan/forwarding-profiles/forwarding-profile/<name>
+var vmsDirectorForwardingProfiles = vDevOrgObjectList{
+	path: []string{"sd-wan", "forwarding-profiles"},
+	list: "forwarding-profile",
+}
+
+/*
+ * Rules of an SD-WAN policy are an ordered list below the policy, e.g.
+ * .../sd-wan/policies/sdwan-policy-group/<policy>/rules/rule/<rule>
+ */
+func vmsDirectorSDWANRules(policyName string) vDevOrgObjectList {
+	return vDevOrgObjectList{
+		path: []string{"sd-wan", "policies", "sdwan-policy-group", policyName, "rules"},
+		list: "rule",
+	}
+}
+
+/* Load balancing modes of forwarding profiles */
+const (
+	LoadBalancePerFlow   = "per-flow"
+	LoadBalancePerPacket = "per-packet"
+)
+
+/*
+ * SLA profile, paths exceeding any threshold violate the SLA. Latency and
+ * jitter are in milliseconds, loss in percent.
+ */
+type DevSLAProfile struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tag,omitempty"`
+	Latency     *int64   `json:"latency,omitempty"`
+	Jitter      *int64   `json:"delay-variation,omitempty"`
+	Loss        *float64 `json:"loss-percentage,omitempty"`
+}
+
+/*
+ * Forwarding profile steering traffic over WAN circuits. Circuits of the
+ * lowest priority value are used first, as long as they meet the SLA.
+ */
+type DevForwardingProfile struct {
+	Name              string                         `json:"name"`
+	Description       string                         `json:"description,omitempty"`
+	Tags              []string                       `json:"tag,omitempty"`
+	CircuitPriorities []DevForwardingCircuitPriority `json:"circuit-priorities,omitempty"`
+	LoadBalance       string                         `json:"load-balance,omitempty"`
+	SLAProfile        string                         `json:"sla-profile,omitempty"`
+	Replication       *DevForwardingReplication      `json:"replication,omitempty"`
+	FEC               *DevForwardingFEC              `json:"fec,omitempty"`
+}
+
+type DevForwardingCircuitPriority struct {
+	Priority int64    `json:"priority"`
+	Circuits []string `json:"circuit-names"`
+}
+
+/*
+ * Packets are sent over Factor circuits at the same time.
+ */
+type DevForwardingReplication struct {
+	Enable bool   `json:"enable"`
+	Factor *int64 `json:"replication-factor,omitempty"`
+}
+
+type DevForwardingFEC struct {
+	Enable bool `json:"enable"`
+}
+
+/*
+ * Rule of an SD-WAN or app-QoS policy, traffic matching the rule gets the
+ * profile of Set. Empty match criteria match any.
+ */
+type DevPolicyRule[S any] struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Tags        []string           `json:"tag,omitempty"`
+	Disabled    bool               `json:"rule-disable,omitempty"`
+	Match       DevPolicyRuleMatch `json:"match"`
+	Set         S                  `json:"set"`
+}
+
+type DevPolicyRuleMatch struct {
+	Source      *DevPolicyRuleEndpoint `json:"source,omitempty"`
+	Destination *DevPolicyRuleEndpoint `json:"destination,omitempty"`
+	Application *DevSecurityRuleNames  `json:"application,omitempty"`
+	DSCP        []int64                `json:"dscp,omitempty"`
+}
+
+type DevPolicyRuleEndpoint struct {
+	Addresses     []string `json:"address-list,omitempty"`
+	AddressGroups []string `json:"address-group-list,omitempty"`
+}
+
+/*
+ * SD-WAN policy rule, traffic matching the rule is steered with the
+ * forwarding profile.
+ */
+type DevSDWANRule = DevPolicyRule[DevSDWANRuleSet]
+
+type DevSDWANRuleSet struct {
+	ForwardingProfile string `json:"forwarding-profile"`
+}
+
+func (c *Client) CreateDevOrgSLAProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevSLAProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorSLAProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgSLAProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevSLAProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorSLAProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgSLAProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorSLAProfiles, name)
+}
+
+func (c *Client) CreateDevOrgForwardingProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevForwardingProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorForwardingProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgForwardingProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevForwardingProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorForwardingProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgForwardingProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorForwardingProfiles, name)
+}
+
+/*
+ * Create a rule in SD-WAN policy at position, point of position is a rule
+ * name of the same policy.
+ */
+func (c *Client) CreateDevOrgSDWANRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevSDWANRule, position ObjectPosition) error {
+
+	return vDevOrgObjectCreateAt(ctx, c, deviceName, organizationName,
+		vmsDirectorSDWANRules(policyName), rule.Name, rule, position)
+}
+
+/*
+ * Update a rule in place, its position in the policy doesn't change.
+ */
+func (c *Client) UpdateDevOrgSDWANRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevSDWANRule) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorSDWANRules(policyName), rule.Name, rule)
+}
+
+func (c *Client) DeleteDevOrgSDWANRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorSDWANRules(policyName), name)
+}
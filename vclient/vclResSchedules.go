@@ -0,0 +1,65 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/objects/schedules/schedule/<name>
+var vmsDirectorObjectsSchedules = vDevOrgObjectList{
+	path: []string{"objects", "schedules"},
+	list: "schedule",
+}
+
+/*
+ * Schedule of an organization, either recurring or non recurring. Times
+ * of day are ranges such as 08:00-17:30.
+ */
+type DevObjectSchedule struct {
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description,omitempty"`
+	Tags         []string                    `json:"tag,omitempty"`
+	Recurring    *DevObjectScheduleRecurring `json:"recurring,omitempty"`
+	NonRecurring []DevObjectScheduleInterval `json:"non-recurring,omitempty"`
+}
+
+/*
+ * Recurring schedule, daily windows apply every day, weekly ones are
+ * keyed by lower case day name such as monday.
+ */
+type DevObjectScheduleRecurring struct {
+	Daily  *DevObjectScheduleTimes           `json:"daily,omitempty"`
+	Weekly map[string]DevObjectScheduleTimes `json:"weekly,omitempty"`
+}
+
+type DevObjectScheduleTimes struct {
+	TimeOfDay []string `json:"time-of-day"`
+}
+
+/*
+ * Non recurring interval, start and end are formatted as 2024-01-31T17:30.
+ */
+type DevObjectScheduleInterval struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+func (c *Client) CreateDevOrgServiceObjSchedule(ctx context.Context,
+	deviceName string, organizationName string, schedule DevObjectSchedule) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsSchedules, schedule.Name, schedule)
+}
+
+func (c *Client) UpdateDevOrgServiceObjSchedule(ctx context.Context,
+	deviceName string, organizationName string, schedule DevObjectSchedule) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsSchedules, schedule.Name, schedule)
+}
+
+func (c *Client) DeleteDevOrgServiceObjSchedule(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsSchedules, name)
+}
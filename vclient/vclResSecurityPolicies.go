@@ -0,0 +1,184 @@
+package vclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// .../org-services/<org>/security/access-policies/access-policy-group/<name>
+var vmsDirectorSecurityPolicies = vDevOrgObjectList{
+	path: []string{"security", "access-policies"},
+	list: "access-policy-group",
+}
+
+/*
+ * Rules of an access policy are an ordered list below the policy, e.g.
+ * .../access-policy-group/<policy>/rules/access-policy/<rule>
+ */
+func vmsDirectorSecurityRules(policyName string) vDevOrgObjectList {
+	return vDevOrgObjectList{
+		path: []string{"security", "access-policies", "access-policy-group",
+			policyName, "rules"},
+		list: "access-policy",
+	}
+}
+
+/* Actions of security access rules */
+const (
+	SecurityRuleActionAllow  = "allow"
+	SecurityRuleActionDeny   = "deny"
+	SecurityRuleActionReject = "reject"
+)
+
+/*
+ * Next generation firewall access policy of an organization, rules are
+ * managed separately.
+ */
+type DevSecurityPolicy struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tag,omitempty"`
+}
+
+/*
+ * Access rule of a security policy. Objects are matched by name, empty
+ * match criteria match any.
+ */
+type DevSecurityRule struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tag,omitempty"`
+	Disabled    bool                 `json:"rule-disable,omitempty"`
+	Match       DevSecurityRuleMatch `json:"match"`
+	Set         DevSecurityRuleSet   `json:"set"`
+}
+
+type DevSecurityRuleMatch struct {
+	Source      *DevSecurityRuleEndpoint `json:"source,omitempty"`
+	Destination *DevSecurityRuleEndpoint `json:"destination,omitempty"`
+	Services    *DevSecurityRuleServices `json:"services,omitempty"`
+	Application *DevSecurityRuleNames    `json:"application,omitempty"`
+	URLCategory *DevSecurityRuleNames    `json:"url-category,omitempty"`
+}
+
+/*
+ * Source or destination match, users apply to source only.
+ */
+type DevSecurityRuleEndpoint struct {
+	Zones         []string `json:"zone-list,omitempty"`
+	Addresses     []string `json:"address-list,omitempty"`
+	AddressGroups []string `json:"address-group-list,omitempty"`
+	Users         []string `json:"user-list,omitempty"`
+}
+
+type DevSecurityRuleServices struct {
+	Services      []string `json:"service-list,omitempty"`
+	ServiceGroups []string `json:"service-group-list,omitempty"`
+}
+
+/*
+ * Predefined and user defined names of applications or URL categories.
+ */
+type DevSecurityRuleNames struct {
+	Predefined  []string `json:"predefined-list,omitempty"`
+	UserDefined []string `json:"user-defined-list,omitempty"`
+}
+
+type DevSecurityRuleSet struct {
+	Action          string                   `json:"action"`
+	SecurityProfile *DevSecurityRuleProfiles `json:"security-profile,omitempty"`
+}
+
+/*
+ * Names of security profiles attached to an allow rule.
+ */
+type DevSecurityRuleProfiles struct {
+	URLFiltering  string `json:"url-filtering,omitempty"`
+	IPS           string `json:"ips,omitempty"`
+	Antivirus     string `json:"av,omitempty"`
+	FileFiltering string `json:"file-filtering,omitempty"`
+}
+
+func (c *Client) CreateDevOrgSecurityPolicy(ctx context.Context,
+	deviceName string, organizationName string, policy DevSecurityPolicy) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityPolicies, policy.Name, policy)
+}
+
+/*
+ * Update policy attributes. Director replaces the whole policy on PUT, so
+ * the current policy is read first to send its rules back unchanged.
+ */
+func (c *Client) UpdateDevOrgSecurityPolicy(ctx context.Context,
+	deviceName string, organizationName string, policy DevSecurityPolicy) error {
+
+	current, err := vDevOrgObjectGet[map[string]json.RawMessage](ctx, c, deviceName,
+		organizationName, vmsDirectorSecurityPolicies, policy.Name)
+	if err != nil {
+		return fmt.Errorf("%v %v: %w", vmsDirectorSecurityPolicies.list, policy.Name, err)
+	}
+
+	attributes, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("%v %v: %w", vmsDirectorSecurityPolicies.list, policy.Name, err)
+	}
+	updated := map[string]json.RawMessage{}
+	if err := json.Unmarshal(attributes, &updated); err != nil {
+		return fmt.Errorf("%v %v: %w", vmsDirectorSecurityPolicies.list, policy.Name, err)
+	}
+	for key, val := range *current {
+		if _, ok := vSecurityPolicyAttributes[key]; !ok {
+			updated[key] = val
+		}
+	}
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityPolicies, policy.Name, updated)
+}
+
+/* json keys of DevSecurityPolicy, everything else is kept on update */
+var vSecurityPolicyAttributes = map[string]struct{}{
+	"name": {}, "description": {}, "tag": {},
+}
+
+/*
+ * Delete a policy along with all of its rules.
+ */
+func (c *Client) DeleteDevOrgSecurityPolicy(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityPolicies, name)
+}
+
+/*
+ * Create a rule in policy at position, point of position is a rule name
+ * of the same policy.
+ */
+func (c *Client) CreateDevOrgSecurityRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevSecurityRule, position ObjectPosition) error {
+
+	return vDevOrgObjectCreateAt(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityRules(policyName), rule.Name, rule, position)
+}
+
+/*
+ * Update a rule in place, its position in the policy doesn't change.
+ */
+func (c *Client) UpdateDevOrgSecurityRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string,
+	rule DevSecurityRule) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityRules(policyName), rule.Name, rule)
+}
+
+func (c *Client) DeleteDevOrgSecurityRule(ctx context.Context,
+	deviceName string, organizationName string, policyName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorSecurityRules(policyName), name)
+}
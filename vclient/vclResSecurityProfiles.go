@@ -0,0 +1,192 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/security/profiles/url-filtering/url-filtering-profile/<name>
+var vmsDirectorURLFilteringProfiles = vDevOrgObjectList{
+	path: []string{"security", "profiles", "url-filtering"},
+	list: "url-filtering-profile",
+}
+
+// IPS profiles are vulnerability profiles in director configuration
+var vmsDirectorIPSProfiles = vDevOrgObjectList{
+	path: []string{"security", "profiles", "vulnerability"},
+	list: "vulnerability-profile",
+}
+
+var vmsDirectorAntivirusProfiles = vDevOrgObjectList{
+	path: []string{"security", "profiles", "antivirus"},
+	list: "antivirus-profile",
+}
+
+var vmsDirectorFileFilteringProfiles = vDevOrgObjectList{
+	path: []string{"security", "profiles", "file-filtering"},
+	list: "file-filtering-profile",
+}
+
+/*
+ * URL filtering profile. Category actions are evaluated after the deny
+ * and allow lists, traffic matching no category gets the default action.
+ */
+type DevURLFilteringProfile struct {
+	Name            string                          `json:"name"`
+	Description     string                          `json:"description,omitempty"`
+	Tags            []string                        `json:"tag,omitempty"`
+	DefaultAction   string                          `json:"default-action"`
+	CategoryActions []DevURLFilteringCategoryAction `json:"category-action-map,omitempty"`
+	AllowList       *DevURLFilteringPatterns        `json:"whitelist,omitempty"`
+	DenyList        *DevURLFilteringPatterns        `json:"blacklist,omitempty"`
+}
+
+type DevURLFilteringCategoryAction struct {
+	Name          string                `json:"name"`
+	Action        string                `json:"action"`
+	URLCategories *DevSecurityRuleNames `json:"url-categories,omitempty"`
+}
+
+type DevURLFilteringPatterns struct {
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+/*
+ * IPS profile. Rules select signatures by severity, overrides change the
+ * action of single signatures.
+ */
+type DevIPSProfile struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Tags        []string         `json:"tag,omitempty"`
+	Rules       []DevIPSRule     `json:"rules,omitempty"`
+	Overrides   []DevIPSOverride `json:"signature-overrides,omitempty"`
+}
+
+type DevIPSRule struct {
+	Name       string   `json:"name"`
+	Severities []string `json:"severity,omitempty"`
+	Action     string   `json:"action"`
+}
+
+type DevIPSOverride struct {
+	SignatureID int64  `json:"signature-id"`
+	Action      string `json:"action"`
+}
+
+/*
+ * Antivirus profile, files transferred with the given protocols are
+ * scanned and infected ones handled with action.
+ */
+type DevAntivirusProfile struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tag,omitempty"`
+	Action      string   `json:"action"`
+	Direction   string   `json:"scan-direction,omitempty"`
+	Protocols   []string `json:"protocols,omitempty"`
+	FileTypes   []string `json:"file-types,omitempty"`
+}
+
+/*
+ * File filtering profile. Rules are evaluated in order, files matching no
+ * rule get the default action.
+ */
+type DevFileFilteringProfile struct {
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description,omitempty"`
+	Tags          []string               `json:"tag,omitempty"`
+	DefaultAction string                 `json:"default-action,omitempty"`
+	Rules         []DevFileFilteringRule `json:"rules,omitempty"`
+}
+
+type DevFileFilteringRule struct {
+	Name      string   `json:"name"`
+	FileTypes []string `json:"file-types,omitempty"`
+	Protocols []string `json:"protocols,omitempty"`
+	Direction string   `json:"direction,omitempty"`
+	Action    string   `json:"action"`
+}
+
+func (c *Client) CreateDevOrgURLFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevURLFilteringProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorURLFilteringProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgURLFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevURLFilteringProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorURLFilteringProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgURLFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorURLFilteringProfiles, name)
+}
+
+func (c *Client) CreateDevOrgIPSProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevIPSProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorIPSProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgIPSProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevIPSProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorIPSProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgIPSProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorIPSProfiles, name)
+}
+
+func (c *Client) CreateDevOrgAntivirusProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevAntivirusProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorAntivirusProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgAntivirusProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevAntivirusProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorAntivirusProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgAntivirusProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorAntivirusProfiles, name)
+}
+
+func (c *Client) CreateDevOrgFileFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevFileFilteringProfile) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorFileFilteringProfiles, profile.Name, profile)
+}
+
+func (c *Client) UpdateDevOrgFileFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, profile DevFileFilteringProfile) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorFileFilteringProfiles, profile.Name, profile)
+}
+
+func (c *Client) DeleteDevOrgFileFilteringProfile(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorFileFilteringProfiles, name)
+}
@@ -0,0 +1,94 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/objects/services/service/<name>
+var vmsDirectorObjectsServices = vDevOrgObjectList{
+	path: []string{"objects", "services"},
+	list: "service",
+}
+
+// .../org-services/<org>/objects/service-groups/group/<name>
+var vmsDirectorObjectsServiceGroups = vDevOrgObjectList{
+	path: []string{"objects", "service-groups"},
+	list: "group",
+}
+
+/*
+ * Custom service of an organization. Protocol is a name such as tcp or
+ * udp, ProtocolValue an IP protocol number used for other protocols.
+ * Ports are a single port or a range such as 1024-2048.
+ */
+type DevObjectService struct {
+	Name            string                `json:"name"`
+	Description     string                `json:"description,omitempty"`
+	Tags            []string              `json:"tag,omitempty"`
+	Protocol        string                `json:"protocol,omitempty"`
+	ProtocolValue   *int64                `json:"protocol-value,omitempty"`
+	SourcePort      string                `json:"source-port,omitempty"`
+	DestinationPort string                `json:"destination-port,omitempty"`
+	ICMP            *DevObjectServiceICMP `json:"icmp,omitempty"`
+}
+
+/*
+ * ICMP match of a service, unset type or code matches any.
+ */
+type DevObjectServiceICMP struct {
+	Type *int64 `json:"type,omitempty"`
+	Code *int64 `json:"code,omitempty"`
+}
+
+/*
+ * Service group of an organization, members are referenced by name.
+ */
+type DevObjectServiceGroup struct {
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Tags          []string `json:"tag,omitempty"`
+	Services      []string `json:"service-list,omitempty"`
+	ServiceGroups []string `json:"service-group-list,omitempty"`
+}
+
+func (c *Client) CreateDevOrgServiceObjService(ctx context.Context,
+	deviceName string, organizationName string, service DevObjectService) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServices, service.Name, service)
+}
+
+func (c *Client) UpdateDevOrgServiceObjService(ctx context.Context,
+	deviceName string, organizationName string, service DevObjectService) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServices, service.Name, service)
+}
+
+func (c *Client) DeleteDevOrgServiceObjService(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServices, name)
+}
+
+func (c *Client) CreateDevOrgServiceObjServiceGroup(ctx context.Context,
+	deviceName string, organizationName string, group DevObjectServiceGroup) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServiceGroups, group.Name, group)
+}
+
+func (c *Client) UpdateDevOrgServiceObjServiceGroup(ctx context.Context,
+	deviceName string, organizationName string, group DevObjectServiceGroup) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServiceGroups, group.Name, group)
+}
+
+func (c *Client) DeleteDevOrgServiceObjServiceGroup(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsServiceGroups, name)
+}
@@ -0,0 +1,45 @@
+package vclient
+
+import (
+	"context"
+)
+
+// .../org-services/<org>/objects/zones/zone/<name>
+var vmsDirectorObjectsZones = vDevOrgObjectList{
+	path: []string{"objects", "zones"},
+	list: "zone",
+}
+
+/*
+ * Security zone of an organization. Traffic is bound to a zone through
+ * member interfaces, networks or routing instances.
+ */
+type DevObjectZone struct {
+	Name             string   `json:"name"`
+	Description      string   `json:"description,omitempty"`
+	Tags             []string `json:"tag,omitempty"`
+	Interfaces       []string `json:"interface-list,omitempty"`
+	Networks         []string `json:"networks,omitempty"`
+	RoutingInstances []string `json:"routing-instance-list,omitempty"`
+}
+
+func (c *Client) CreateDevOrgServiceObjZone(ctx context.Context,
+	deviceName string, organizationName string, zone DevObjectZone) error {
+
+	return vDevOrgObjectCreate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsZones, zone.Name, zone)
+}
+
+func (c *Client) UpdateDevOrgServiceObjZone(ctx context.Context,
+	deviceName string, organizationName string, zone DevObjectZone) error {
+
+	return vDevOrgObjectUpdate(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsZones, zone.Name, zone)
+}
+
+func (c *Client) DeleteDevOrgServiceObjZone(ctx context.Context,
+	deviceName string, organizationName string, name string) error {
+
+	return vDevOrgObjectDelete(ctx, c, deviceName, organizationName,
+		vmsDirectorObjectsZones, name)
+}